@@ -1,7 +1,11 @@
 package unicreds
 
 import (
+	"bufio"
 	"fmt"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/apex/log"
 	"github.com/aws/aws-sdk-go/aws"
@@ -9,41 +13,86 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 )
 
-const (
-	zoneURL = "http://169.254.169.254/latest/meta-data/placement/availability-zone"
-)
+// maxChainedRoleDuration sts limits sessions assumed using role credentials to an hour
+const maxChainedRoleDuration = time.Hour
+
+// awsRegion the region resolved by the most recent aws configuration
+var awsRegion, awsRegionSource string
+
+// RoleOptions settings applied when assuming roles
+type RoleOptions struct {
+	// ExternalID passed when assuming each role
+	ExternalID string
+
+	// MFASerial serial number or ARN of the MFA device used to assume the first role
+	MFASerial string
+
+	// MFAToken token code for the MFA device, if empty the token provider is used
+	MFAToken string
+
+	// TokenProvider called for the MFA token code when no token is supplied, defaults to
+	// prompting on stderr so stdout is left for the command's output
+	TokenProvider func() (string, error)
+
+	// SessionName role session name recorded in CloudTrail
+	SessionName string
+
+	// Duration of the role session, roles assumed by a chain are limited to an hour
+	Duration time.Duration
+}
 
 // SetAwsConfig configure the AWS region with a fallback for discovery
-// on EC2 hosts.
+// using the environment, profile, and EC2 or ECS metadata.
 func SetAwsConfig(region, profile *string, role *string) (err error) {
-	if region == nil {
-		// Try to get our region based on instance metadata
-		region, err = getRegion()
-		if err != nil {
-			return err
-		}
+	var roles []string
+	if aws.StringValue(role) != "" {
+		roles = append(roles, *role)
+	}
+
+	return SetAwsConfigWithRoles(region, profile, roles, nil)
+}
+
+// SetAwsConfigWithRoles configure the AWS region and assume each of the supplied roles
+// in order, using the credentials of the previous role to assume the next.
+func SetAwsConfigWithRoles(region, profile *string, roles []string, opts *RoleOptions) (err error) {
+	resolved, source, err := ResolveRegion(aws.StringValue(region), aws.StringValue(profile))
+	if err != nil {
+		return err
 	}
 
 	// This is to work around a limitation of the credentials
 	// chain when providing an AWS profile as a flag
-	if aws.StringValue(region) == "" && aws.StringValue(profile) != "" {
+	if resolved == "" && aws.StringValue(profile) != "" {
 		return fmt.Errorf("Must provide a region flag when specifying a profile")
 	}
 
-	setAwsConfig(region, profile, role)
+	if opts == nil {
+		opts = &RoleOptions{}
+	}
+
+	log.WithFields(log.Fields{"region": resolved, "source": source}).Debug("Resolved region")
+
+	awsRegionSource = source
+
+	setAwsConfig(aws.String(resolved), profile, roles, opts)
 	return nil
 }
 
-func setAwsConfig(region, profile, role *string) {
+func setAwsConfig(region, profile *string, roles []string, opts *RoleOptions) {
 	log.WithFields(log.Fields{"region": aws.StringValue(region), "profile": aws.StringValue(profile)}).Debug("Configure AWS")
 
-	sess := getAwsSession(region, profile, role)
+	sess := getAwsSession(region, profile, roles, opts)
+
+	awsRegion = aws.StringValue(sess.Config.Region)
 
 	SetDynamoDBSession(sess)
+	SetDynamoDBStreamsSession(sess)
 	SetKMSSession(sess)
+	SetSTSSession(sess)
+	SetSNSSession(sess)
 }
 
-func getAwsSession(region, profile, role *string) *session.Session {
+func getAwsSession(region, profile *string, roles []string, opts *RoleOptions) *session.Session {
 	config := aws.Config{Region: region}
 
 	// If no role is supplied, use the shared AWS config
@@ -53,14 +102,61 @@ func getAwsSession(region, profile, role *string) *session.Session {
 		Profile:           aws.StringValue(profile),
 	}))
 
-	// If a role is supplied, return a new session using STS-generated credentials
-	if aws.StringValue(role) != "" {
-		log.WithFields(log.Fields{"role": aws.StringValue(role), "profile": aws.StringValue(profile)}).Debug("AssumeRole")
-		config.Credentials = stscreds.NewCredentials(sess, *role)
+	// If roles are supplied, assume each in turn using the credentials of the previous session
+	for i, role := range roles {
+		log.WithFields(log.Fields{"role": role, "profile": aws.StringValue(profile), "chained": i > 0}).Debug("AssumeRole")
+		config.Credentials = stscreds.NewCredentials(sess, role, assumeRoleOptions(opts, i > 0))
 
-		return session.Must(session.NewSession(&config))
+		sess = session.Must(session.NewSession(&config))
 	}
 
-	// If no role is assumed, return initial session
 	return sess
 }
+
+// assumeRoleOptions configure the assume role provider, MFA is only used for the first
+// role as chained roles are assumed using temporary credentials
+func assumeRoleOptions(opts *RoleOptions, chained bool) func(*stscreds.AssumeRoleProvider) {
+	return func(p *stscreds.AssumeRoleProvider) {
+		if opts.ExternalID != "" {
+			p.ExternalID = aws.String(opts.ExternalID)
+		}
+
+		if opts.SessionName != "" {
+			p.RoleSessionName = opts.SessionName
+		}
+
+		if opts.Duration > 0 {
+			p.Duration = opts.Duration
+			if chained && p.Duration > maxChainedRoleDuration {
+				log.WithField("duration", p.Duration).Debug("Limiting chained role duration to an hour")
+				p.Duration = maxChainedRoleDuration
+			}
+		}
+
+		if opts.MFASerial != "" && !chained {
+			p.SerialNumber = aws.String(opts.MFASerial)
+
+			if opts.MFAToken != "" {
+				token := opts.MFAToken
+				p.TokenProvider = func() (string, error) { return token, nil }
+			} else if opts.TokenProvider != nil {
+				p.TokenProvider = opts.TokenProvider
+			} else {
+				p.TokenProvider = StderrTokenProvider
+			}
+		}
+	}
+}
+
+// StderrTokenProvider prompts for the MFA token code on stderr and reads it from stdin,
+// unlike stscreds.StdinTokenProvider the prompt doesn't end up in redirected output
+func StderrTokenProvider() (string, error) {
+	fmt.Fprint(os.Stderr, "Assume Role MFA token code: ")
+
+	token, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(token), nil
+}
@@ -2,8 +2,10 @@ package unicreds
 
 import (
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -24,3 +26,58 @@ func TestConfig(t *testing.T) {
 	err = SetAwsConfig(aws.String("us-west-2"), aws.String("wolfeidau"), aws.String("role"))
 	assert.Nil(t, err)
 }
+
+func TestConfigWithRoles(t *testing.T) {
+
+	roles := []string{"arn:aws:iam::123456789012:role/first", "arn:aws:iam::210987654321:role/second"}
+
+	err := SetAwsConfigWithRoles(aws.String("us-west-2"), nil, roles, &RoleOptions{
+		ExternalID:  "external",
+		MFASerial:   "arn:aws:iam::123456789012:mfa/user",
+		MFAToken:    "123456",
+		SessionName: "audit",
+		Duration:    2 * time.Hour,
+	})
+	assert.Nil(t, err)
+}
+
+func TestAssumeRoleOptions(t *testing.T) {
+
+	opts := &RoleOptions{
+		ExternalID:  "external",
+		MFASerial:   "arn:aws:iam::123456789012:mfa/user",
+		MFAToken:    "123456",
+		SessionName: "audit",
+		Duration:    2 * time.Hour,
+	}
+
+	first := &stscreds.AssumeRoleProvider{}
+	assumeRoleOptions(opts, false)(first)
+
+	assert.Equal(t, "external", aws.StringValue(first.ExternalID))
+	assert.Equal(t, "arn:aws:iam::123456789012:mfa/user", aws.StringValue(first.SerialNumber))
+	assert.Equal(t, "audit", first.RoleSessionName)
+	assert.Equal(t, 2*time.Hour, first.Duration)
+
+	token, err := first.TokenProvider()
+	assert.Nil(t, err)
+	assert.Equal(t, "123456", token)
+
+	chained := &stscreds.AssumeRoleProvider{}
+	assumeRoleOptions(opts, true)(chained)
+
+	assert.Equal(t, "external", aws.StringValue(chained.ExternalID))
+	assert.Nil(t, chained.SerialNumber)
+	assert.Equal(t, time.Hour, chained.Duration)
+
+	// without a token the provider is asked for one
+	opts.MFAToken = ""
+	opts.TokenProvider = func() (string, error) { return "654321", nil }
+
+	prompted := &stscreds.AssumeRoleProvider{}
+	assumeRoleOptions(opts, false)(prompted)
+
+	token, err = prompted.TokenProvider()
+	assert.Nil(t, err)
+	assert.Equal(t, "654321", token)
+}
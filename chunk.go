@@ -0,0 +1,236 @@
+package unicreds
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+const (
+	// CompressionGzip the secret was compressed with gzip before it was encrypted
+	CompressionGzip = "gzip"
+
+	// maxChunkSize largest encoded contents stored on a single item, leaving room for the
+	// other attributes within the 400KB dynamodb item limit
+	maxChunkSize = 350 * 1024
+
+	// chunkMarker separates the name of a secret from the id of its chunks
+	chunkMarker = "#chunk#"
+)
+
+var (
+	// ErrChunksIncomplete returned when the items holding a chunked secret can't all be found
+	ErrChunksIncomplete = errors.New("Secret chunks are missing")
+)
+
+// chunkItem an item holding part of the encrypted contents of a large secret
+type chunkItem struct {
+	Name     string `dynamodbav:"name"`
+	Version  string `dynamodbav:"version"`
+	Contents string `dynamodbav:"contents"`
+	ChunkOf  string `dynamodbav:"chunk_of"`
+}
+
+// chunkName the name the chunks of a secret are stored under, the id is unique to each write
+// so concurrent writers of the same version can't overwrite each other's chunks
+func chunkName(name, chunkID string) string {
+	return name + chunkMarker + chunkID
+}
+
+func newChunkID() (string, error) {
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id), nil
+}
+
+// splitContents split the encoded contents into pieces which each fit on an item
+func splitContents(contents string) []string {
+	var chunks []string
+	for len(contents) > maxChunkSize {
+		chunks = append(chunks, contents[:maxChunkSize])
+		contents = contents[maxChunkSize:]
+	}
+	return append(chunks, contents)
+}
+
+func compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func decompress(compression string, data []byte) ([]byte, error) {
+	switch compression {
+	case "":
+		return data, nil
+	case CompressionGzip:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return ioutil.ReadAll(r)
+	default:
+		return nil, fmt.Errorf("unsupported compression %q", compression)
+	}
+}
+
+// putChunks store the chunks following the first in their own items
+func putChunks(tableName *string, cred *Credential, chunks []string) error {
+	for i, chunk := range chunks {
+		data, err := Encode(&chunkItem{
+			Name:     chunkName(cred.Name, cred.ChunkID),
+			Version:  PaddedInt(i + 1),
+			Contents: chunk,
+			ChunkOf:  cred.Name,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = dynamoSvc.PutItem(&dynamodb.PutItemInput{
+			TableName: tableName,
+			Item:      data,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// queryChunks returns the chunk items of a secret in order
+func queryChunks(tableName *string, name, chunkID string) ([]*chunkItem, error) {
+	var chunks []*chunkItem
+	var lastEvaluatedKey map[string]*dynamodb.AttributeValue
+
+	for {
+		res, err := dynamoSvc.Query(&dynamodb.QueryInput{
+			TableName: tableName,
+			ExpressionAttributeNames: map[string]*string{
+				"#N": aws.String("name"),
+			},
+			ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+				":name": {
+					S: aws.String(chunkName(name, chunkID)),
+				},
+			},
+			KeyConditionExpression: aws.String("#N = :name"),
+			ConsistentRead:         aws.Bool(true),
+			ExclusiveStartKey:      lastEvaluatedKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range res.Items {
+			chunk := new(chunkItem)
+			if err := Decode(item, chunk); err != nil {
+				return nil, err
+			}
+			chunks = append(chunks, chunk)
+		}
+
+		lastEvaluatedKey = res.LastEvaluatedKey
+		if lastEvaluatedKey == nil {
+			break
+		}
+	}
+
+	return chunks, nil
+}
+
+// assembleChunks append the contents of any chunk items to the credential
+func assembleChunks(tableName *string, cred *Credential) error {
+	if cred.Chunks <= 1 {
+		return nil
+	}
+
+	log.WithFields(log.Fields{"name": cred.Name, "chunks": cred.Chunks}).Debug("Assembling chunks")
+
+	chunks, err := queryChunks(tableName, cred.Name, cred.ChunkID)
+	if err != nil {
+		return err
+	}
+
+	if len(chunks) != cred.Chunks-1 {
+		return ErrChunksIncomplete
+	}
+
+	var contents strings.Builder
+	contents.WriteString(cred.Contents)
+
+	for _, chunk := range chunks {
+		contents.WriteString(chunk.Contents)
+	}
+
+	cred.Contents = contents.String()
+	cred.Chunks = 0
+
+	return nil
+}
+
+// deleteChunks remove the chunk items of a secret
+func deleteChunks(tableName *string, name, chunkID string) error {
+	chunks, err := queryChunks(tableName, name, chunkID)
+	if err != nil {
+		return err
+	}
+
+	for _, chunk := range chunks {
+		_, err = dynamoSvc.DeleteItem(&dynamodb.DeleteItemInput{
+			TableName: tableName,
+			Key: map[string]*dynamodb.AttributeValue{
+				"name": {
+					S: aws.String(chunk.Name),
+				},
+				"version": {
+					S: aws.String(chunk.Version),
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeChunks clean up the chunks written for a secret which couldn't be stored
+func removeChunks(tableName *string, cred *Credential) {
+	if err := deleteChunks(tableName, cred.Name, cred.ChunkID); err != nil {
+		log.WithFields(log.Fields{"name": cred.Name, "err": err}).Warn("Failed to remove chunks")
+	}
+}
+
+// withoutChunks filter chunk items out of a scan of the table
+func withoutChunks(creds []*Credential) []*Credential {
+	filtered := creds[:0]
+	for _, cred := range creds {
+		if cred.ChunkOf == "" {
+			filtered = append(filtered, cred)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,116 @@
+package unicreds
+
+import (
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/versent/unicreds/mocks"
+)
+
+// configureChunkMock returns mocks which store secrets in the supplied map of items
+func configureChunkMock(items map[string][]map[string]*dynamodb.AttributeValue) (*mocks.DynamoDBAPI, *mocks.KMSAPI) {
+	dsMock, kmsMock := configureMock()
+
+	dko := &kms.GenerateDataKeyOutput{
+		Plaintext:      append(append([]byte{}, dsPlainText...), dsPlainText...),
+		CiphertextBlob: []byte("wrapped"),
+	}
+
+	kmsMock.On("GenerateDataKey", mock.AnythingOfType("*kms.GenerateDataKeyInput")).Return(dko, nil)
+	kmsMock.On("Decrypt", mock.AnythingOfType("*kms.DecryptInput")).Return(&kms.DecryptOutput{Plaintext: dko.Plaintext}, nil)
+
+	dsMock.On("PutItem", mock.AnythingOfType("*dynamodb.PutItemInput")).Return(&dynamodb.PutItemOutput{}, nil).Run(func(args mock.Arguments) {
+		item := args.Get(0).(*dynamodb.PutItemInput).Item
+		name := aws.StringValue(item["name"].S)
+		items[name] = append(items[name], item)
+	})
+
+	dsMock.On("Query", mock.AnythingOfType("*dynamodb.QueryInput")).Return(func(input *dynamodb.QueryInput) *dynamodb.QueryOutput {
+		return &dynamodb.QueryOutput{Items: items[aws.StringValue(input.ExpressionAttributeValues[":name"].S)]}
+	}, nil)
+
+	return dsMock, kmsMock
+}
+
+func TestPutSecretChunked(t *testing.T) {
+
+	items := map[string][]map[string]*dynamodb.AttributeValue{}
+	dsMock, _ := configureChunkMock(items)
+
+	// random data doesn't compress so has to be split
+	data := make([]byte, 2*maxChunkSize)
+	_, err := rand.Read(data)
+	assert.Nil(t, err)
+
+	err = PutSecret(&tableName, "", "large", string(data), PaddedInt(1), NewEncryptionContextValue())
+	assert.Nil(t, err)
+
+	assert.Len(t, items["large"], 1)
+	assert.Len(t, items, 2)
+	assert.Equal(t, "large", aws.StringValue(dsMock.Calls[len(dsMock.Calls)-1].Arguments.Get(0).(*dynamodb.PutItemInput).Item["name"].S), "secret is written after its chunks")
+
+	for _, item := range items["large"] {
+		assert.True(t, len(aws.StringValue(item["contents"].S)) <= maxChunkSize)
+	}
+
+	ds, err := GetHighestVersionSecret(&tableName, "large", NewEncryptionContextValue())
+	assert.Nil(t, err)
+	assert.Equal(t, data, []byte(ds.Secret))
+}
+
+func TestPutSecretCompressed(t *testing.T) {
+
+	items := map[string][]map[string]*dynamodb.AttributeValue{}
+	configureChunkMock(items)
+
+	secret := strings.Repeat("-----BEGIN CERTIFICATE-----\n", 2*maxChunkSize/28)
+
+	err := PutSecret(&tableName, "", "bundle", secret, PaddedInt(1), NewEncryptionContextValue())
+	assert.Nil(t, err)
+
+	assert.Len(t, items, 1)
+	assert.Equal(t, CompressionGzip, aws.StringValue(items["bundle"][0]["compression"].S))
+
+	ds, err := GetHighestVersionSecret(&tableName, "bundle", NewEncryptionContextValue())
+	assert.Nil(t, err)
+	assert.Equal(t, secret, ds.Secret)
+}
+
+func TestGetSecretChunksIncomplete(t *testing.T) {
+
+	items := map[string][]map[string]*dynamodb.AttributeValue{}
+	configureChunkMock(items)
+
+	items["large"] = []map[string]*dynamodb.AttributeValue{
+		{
+			"name":     {S: aws.String("large")},
+			"version":  {S: aws.String(PaddedInt(1))},
+			"contents": {S: aws.String("o8we1zr9GD+KstVv3x2YTeT2")},
+			"chunks":   {N: aws.String("3")},
+			"chunk_id": {S: aws.String("abc")},
+		},
+	}
+
+	_, err := GetHighestVersionSecret(&tableName, "large", NewEncryptionContextValue())
+	assert.Equal(t, ErrChunksIncomplete, err)
+}
+
+func TestWithoutChunks(t *testing.T) {
+	creds := []*Credential{
+		{Name: "large", Chunks: 2, ChunkID: "abc"},
+		{Name: chunkName("large", "abc"), ChunkOf: "large"},
+		{Name: "small"},
+	}
+
+	creds = withoutChunks(creds)
+
+	assert.Len(t, creds, 2)
+	assert.Equal(t, "large", creds[0].Name)
+	assert.Equal(t, "small", creds[1].Name)
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/versent/unicreds"
+)
+
+// completionCacheTTL how long the secret names listed for completion are reused, long enough
+// to cover completing a single command without showing stale names for long
+const completionCacheTTL = time.Minute
+
+// completionTimeout how long to wait for the store when there are no cached names
+const completionTimeout = 5 * time.Second
+
+// the kingpin scripts pass the word being completed along with the words before it, which
+// completes the next argument rather than the current one, so only the earlier words are passed
+// unless a flag is being completed
+var completionScripts = map[string]string{
+	"bash": `_unicreds_complete() {
+    local cur="${COMP_WORDS[COMP_CWORD]}" words
+    if [[ "$cur" == -* ]]; then
+        words=("${COMP_WORDS[@]:1:$COMP_CWORD}")
+    else
+        words=("${COMP_WORDS[@]:1:$((COMP_CWORD - 1))}")
+    fi
+    COMPREPLY=($(compgen -W "$("${COMP_WORDS[0]}" --completion-bash "${words[@]}" 2>/dev/null)" -- "$cur"))
+}
+complete -F _unicreds_complete unicreds
+`,
+	"zsh": `#compdef unicreds
+autoload -U compinit && compinit
+autoload -U bashcompinit && bashcompinit
+
+_unicreds_complete() {
+    local cur="${COMP_WORDS[COMP_CWORD]}" words
+    if [[ "$cur" == -* ]]; then
+        words=("${COMP_WORDS[@]:1:$COMP_CWORD}")
+    else
+        words=("${COMP_WORDS[@]:1:$((COMP_CWORD - 1))}")
+    fi
+    COMPREPLY=($(compgen -W "$("${COMP_WORDS[0]}" --completion-bash "${words[@]}" 2>/dev/null)" -- "$cur"))
+}
+complete -F _unicreds_complete unicreds
+`,
+	"fish": `function __unicreds_complete
+    set -l words (commandline -opc)
+    set -l cur (commandline -ct)
+    if string match -q -- '-*' $cur
+        set words $words $cur
+    end
+    $words[1] --completion-bash $words[2..-1] 2>/dev/null
+end
+complete -c unicreds -f -a '(__unicreds_complete)'
+`,
+}
+
+// completionCache secret names and their versions, no values are stored
+type completionCache struct {
+	Versions map[string][]int `json:"versions"`
+}
+
+var (
+	completionOnce    sync.Once
+	completionSecrets *completionCache
+
+	// listCompletionSecrets read the names and versions from the store when the cache is stale
+	listCompletionSecrets = listSecretVersions
+)
+
+func printCompletionScript(shell string) {
+	fmt.Print(completionScripts[shell])
+}
+
+// completeNames hint the names of the secrets in the store
+func completeNames() []string {
+	secrets := cachedSecrets()
+	if secrets == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(secrets.Versions))
+	for name := range secrets.Versions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// completeVersions hint the versions of the named secret
+func completeVersions(name *string) func() []string {
+	return func() []string {
+		secrets := cachedSecrets()
+		if secrets == nil {
+			return nil
+		}
+
+		var versions []string
+		for _, version := range secrets.Versions[*name] {
+			versions = append(versions, strconv.Itoa(version))
+		}
+
+		return versions
+	}
+}
+
+// completeNamesOrVersions hint the versions of the first secret along with the other names, as
+// get takes either a version or further names
+func completeNamesOrVersions(name *string) func() []string {
+	return func() []string {
+		return append(completeVersions(name)(), completeNames()...)
+	}
+}
+
+// cachedSecrets list the secrets in the store, reusing the list from the cache if it's recent.
+// Completion can't prompt, so nothing is listed if an MFA token would be needed.
+func cachedSecrets() *completionCache {
+	completionOnce.Do(func() {
+		path := completionCachePath()
+
+		if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) < completionCacheTTL {
+			if data, err := ioutil.ReadFile(path); err == nil {
+				secrets := new(completionCache)
+				if json.Unmarshal(data, secrets) == nil {
+					completionSecrets = secrets
+					return
+				}
+			}
+		}
+
+		if *roleMFASerial != "" && *roleMFAToken == "" {
+			return
+		}
+
+		// give up rather than leave the shell waiting on retries
+		listed := make(chan *completionCache, 1)
+		go func() { listed <- listCompletionSecrets() }()
+
+		select {
+		case completionSecrets = <-listed:
+		case <-time.After(completionTimeout):
+			return
+		}
+
+		if completionSecrets == nil {
+			return
+		}
+
+		if data, err := json.Marshal(completionSecrets); err == nil && os.MkdirAll(filepath.Dir(path), 0700) == nil {
+			ioutil.WriteFile(path, data, 0600)
+		}
+	})
+
+	return completionSecrets
+}
+
+func listSecretVersions() *completionCache {
+	if _, _, err := configure(); err != nil {
+		return nil
+	}
+
+	creds, err := unicreds.ListSecrets(dynamoTable, true)
+	if err != nil {
+		return nil
+	}
+
+	secrets := &completionCache{Versions: map[string][]int{}}
+	for _, cred := range creds {
+		version, err := strconv.Atoi(cred.Version)
+		if err != nil {
+			continue
+		}
+		secrets.Versions[cred.Name] = append(secrets.Versions[cred.Name], version)
+	}
+	for _, versions := range secrets.Versions {
+		sort.Sort(sort.Reverse(sort.IntSlice(versions)))
+	}
+
+	return secrets
+}
+
+// completionCachePath a cache file for each combination of settings which select the store
+func completionCachePath() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+
+	key := strings.Join([]string{
+		*region, *profile, strings.Join(*role, ","), *dynamoTable, *envName,
+		os.Getenv("AWS_PROFILE"), os.Getenv("AWS_REGION"), os.Getenv("AWS_DEFAULT_REGION"),
+	}, "\x00")
+	sum := sha256.Sum256([]byte(key))
+
+	return filepath.Join(dir, "unicreds", "completion-"+hex.EncodeToString(sum[:8])+".json")
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// withCompletion point the completion cache at a temporary directory and list the secrets with
+// the given function, counting the calls, the returned function restores the defaults
+func withCompletion(t *testing.T, list func() *completionCache) (*int, func()) {
+	dir, err := ioutil.TempDir("", "unicreds")
+	assert.Nil(t, err)
+
+	cacheHome := os.Getenv("XDG_CACHE_HOME")
+	os.Setenv("XDG_CACHE_HOME", dir)
+
+	calls := new(int)
+	listCompletionSecrets = func() *completionCache {
+		*calls++
+		return list()
+	}
+	resetCompletion()
+
+	return calls, func() {
+		listCompletionSecrets = listSecretVersions
+		resetCompletion()
+		os.Setenv("XDG_CACHE_HOME", cacheHome)
+		os.RemoveAll(dir)
+	}
+}
+
+func resetCompletion() {
+	completionOnce = sync.Once{}
+	completionSecrets = nil
+}
+
+func TestCompleteNamesAndVersions(t *testing.T) {
+
+	calls, cleanup := withCompletion(t, func() *completionCache {
+		return &completionCache{Versions: map[string][]int{
+			"bravo": {1},
+			"alpha": {3, 2, 1},
+		}}
+	})
+	defer cleanup()
+
+	name := "alpha"
+	missing := "missing"
+
+	assert.Equal(t, []string{"alpha", "bravo"}, completeNames())
+	assert.Equal(t, []string{"3", "2", "1"}, completeVersions(&name)())
+	assert.Nil(t, completeVersions(&missing)())
+	assert.Equal(t, []string{"3", "2", "1", "alpha", "bravo"}, completeNamesOrVersions(&name)())
+	assert.Equal(t, 1, *calls)
+
+	// a later completion within the TTL reads the cache file rather than the store
+	resetCompletion()
+
+	assert.Equal(t, []string{"alpha", "bravo"}, completeNames())
+	assert.Equal(t, 1, *calls)
+}
+
+func TestCompletionCacheExpired(t *testing.T) {
+
+	calls, cleanup := withCompletion(t, func() *completionCache {
+		return &completionCache{Versions: map[string][]int{"fresh": {1}}}
+	})
+	defer cleanup()
+
+	path := completionCachePath()
+	data, err := json.Marshal(&completionCache{Versions: map[string][]int{"stale": {1}}})
+	assert.Nil(t, err)
+	assert.Nil(t, os.MkdirAll(filepath.Dir(path), 0700))
+	assert.Nil(t, ioutil.WriteFile(path, data, 0600))
+
+	old := time.Now().Add(-2 * completionCacheTTL)
+	assert.Nil(t, os.Chtimes(path, old, old))
+
+	assert.Equal(t, []string{"fresh"}, completeNames())
+	assert.Equal(t, 1, *calls)
+
+	// the expired file is replaced with the fresh list
+	cached, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"versions":{"fresh":[1]}}`, string(cached))
+}
+
+func TestCompletionSkippedWhenMFARequired(t *testing.T) {
+
+	calls, cleanup := withCompletion(t, func() *completionCache {
+		return &completionCache{Versions: map[string][]int{"alpha": {1}}}
+	})
+	defer cleanup()
+
+	serial, token := *roleMFASerial, *roleMFAToken
+	*roleMFASerial, *roleMFAToken = "arn:aws:iam::123456789012:mfa/user", ""
+	defer func() { *roleMFASerial, *roleMFAToken = serial, token }()
+
+	assert.Nil(t, completeNames())
+	assert.Equal(t, 0, *calls)
+
+	_, err := os.Stat(completionCachePath())
+	assert.True(t, os.IsNotExist(err))
+}
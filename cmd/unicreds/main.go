@@ -1,10 +1,15 @@
 package main
 
 import (
+	"bytes"
+	"encoding/base64"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
 	"syscall"
 
 	"github.com/apex/log"
@@ -13,6 +18,7 @@ import (
 
 	"github.com/alecthomas/kingpin"
 	"github.com/versent/unicreds"
+	"golang.org/x/term"
 )
 
 var (
@@ -21,50 +27,129 @@ var (
 	debug   = app.Flag("debug", "Enable debug mode.").Short('d').Bool()
 	logJSON = app.Flag("json", "Output results in JSON").Short('j').Bool()
 
-	region  = app.Flag("region", "Configure the AWS region").Short('r').String()
-	profile = app.Flag("profile", "Configure the AWS profile").Short('p').String()
-	role    = app.Flag("role", "Specify an AWS role ARN to assume").Short('R').String()
+	envName = app.Flag("env", "Named environment to load from the .unicreds.yaml config files").OverrideDefaultFromEnvar("UNICREDS_ENV").String()
 
-	dynamoTable = app.Flag("table", "DynamoDB table.").Default("credential-store").OverrideDefaultFromEnvar("UNICREDS_TABLE").Short('t').String()
-	alias       = app.Flag("alias", "KMS key alias.").Default("alias/credstash").OverrideDefaultFromEnvar("UNICREDS_ALIAS").Short('k').String()
-	encContext  = encryptionContext(app.Flag("enc-context", "Add a key value pair to the encryption context.").Short('E'))
+	region  = app.Flag("region", "Configure the AWS region").OverrideDefaultFromEnvar("UNICREDS_REGION").Short('r').String()
+	profile = app.Flag("profile", "Configure the AWS profile").OverrideDefaultFromEnvar("UNICREDS_PROFILE").Short('p').String()
+	role    = app.Flag("role", "Specify an AWS role ARN to assume, repeat to chain roles in order").Short('R').Strings()
+
+	roleExternalID  = app.Flag("external-id", "External ID used when assuming roles").String()
+	roleMFASerial   = app.Flag("mfa-serial", "Serial number or ARN of the MFA device used to assume the first role").String()
+	roleMFAToken    = app.Flag("mfa-token", "MFA token code, prompted for if an MFA serial is supplied without one").String()
+	roleSessionName = app.Flag("role-session-name", "Session name used when assuming roles").String()
+	roleDuration    = app.Flag("role-duration", "Duration of the assumed role session").Duration()
+
+	dynamoTable    = app.Flag("table", "DynamoDB table, defaults to credential-store.").OverrideDefaultFromEnvar("UNICREDS_TABLE").Short('t').String()
+	alias          = app.Flag("alias", "KMS key alias, defaults to alias/credstash.").OverrideDefaultFromEnvar("UNICREDS_ALIAS").Short('k').String()
+	encContext     = encryptionContext(app.Flag("enc-context", "Add a key value pair to the encryption context.").Short('E'))
+	encFile        = app.Flag("enc-context-file", "Load encryption context pairs from a JSON or YAML file.").String()
+	fingerprintKey = app.Flag("fingerprint-key", "KMS HMAC key used to make fingerprints which are stable between runs.").OverrideDefaultFromEnvar("UNICREDS_FINGERPRINT_KEY").String()
+	recordContext  = app.Flag("record-context", "Store the encryption context keys, or keys and values, with secrets when writing.").Enum("keys", "values")
+	concurrency    = app.Flag("concurrency", "Number of secrets to decrypt in parallel.").Default(strconv.Itoa(unicreds.DefaultDecryptConcurrency)).Int()
+
+	dataKeyCacheTTL  = app.Flag("data-key-cache-ttl", "Cache decrypted data keys in memory for this long, such as 5m for watch.").Duration()
+	dataKeyCacheSize = app.Flag("data-key-cache-size", "Maximum number of decrypted data keys to cache.").Default("1000").Int()
 
 	// commands
 	cmdSetup      = app.Command("setup", "Setup the dynamodb table used to store credentials.")
 	cmdSetupRead  = cmdSetup.Flag("read", "Dynamo read capacity.").Default("4").Int64()
 	cmdSetupWrite = cmdSetup.Flag("write", "Dynamo write capacity.").Default("4").Int64()
 
-	cmdGet        = app.Command("get", "Get a credential from the store.")
-	cmdGetName    = cmdGet.Arg("credential", "The name of the credential to get.").Required().String()
-	cmdGetNoLine  = cmdGet.Flag("noline", "Leave off the newline when emitting secret").Short('n').Bool()
-	cmdGetVersion = cmdGet.Arg("version", "The version of the credential to get.").Int()
+	cmdSetupBillingMode        = cmdSetup.Flag("billing-mode", "Dynamo billing mode, existing tables are left unchanged if not set.").Enum("PROVISIONED", "PAY_PER_REQUEST")
+	cmdSetupTags               = cmdSetup.Flag("tag", "Add a KEY=VALUE tag to the table.").StringMap()
+	cmdSetupSSEKey             = cmdSetup.Flag("sse-kms-key", "KMS key used to encrypt the table at rest.").String()
+	cmdSetupPointInTime        = cmdSetup.Flag("point-in-time-recovery", "Enable point in time recovery for the table.").Bool()
+	cmdSetupDeletionProtection = cmdSetup.Flag("deletion-protection", "Enable deletion protection for the table.").Bool()
+	cmdSetupStreams            = cmdSetup.Flag("streams", "Enable a KEYS_ONLY stream of changes to the table, used by events and watch.").Bool()
+
+	cmdGet            = app.Command("get", "Get a credential from the store.")
+	cmdGetName        = cmdGet.Arg("credential", "The name of the credential to get.").HintAction(completeNames).Required().String()
+	cmdGetNoLine      = cmdGet.Flag("noline", "Leave off the newline when emitting secret").Short('n').Bool()
+	cmdGetOut         = cmdGet.Flag("out", "Write the raw secret to a file rather than stdout.").Short('o').String()
+	cmdGetBase64      = cmdGet.Flag("base64", "Emit the secret base64 encoded.").Bool()
+	cmdGetField       = cmdGet.Flag("field", "Emit a field of a JSON secret, such as password or .db.hosts[0].").String()
+	cmdGetFingerprint = cmdGet.Flag("fingerprint", "Emit a fingerprint of the secret made with the --fingerprint-key rather than the secret.").Bool()
+	cmdGetVersion     = cmdGet.Arg("version", "The version of the credential to get, or further credential names to get.").HintAction(completeNamesOrVersions(cmdGetName)).Strings()
 
 	cmdGetAll         = app.Command("getall", "Get latest credentials from the store.")
 	cmdGetAllVersions = cmdGetAll.Flag("all", "List all versions").Bool()
 
 	cmdList            = app.Command("list", "List latest credentials with names and version.")
 	cmdListAllVersions = cmdList.Flag("all", "List all versions").Bool()
+	cmdListLong        = cmdList.Flag("long", "Include the encryption context stored with each credential.").Short('l').Bool()
 
-	cmdPut        = app.Command("put", "Put a credential into the store.")
-	cmdPutName    = cmdPut.Arg("credential", "The name of the credential to store.").Required().String()
-	cmdPutSecret  = cmdPut.Arg("value", "The value of the credential to store.").Required().String()
-	cmdPutVersion = cmdPut.Arg("version", "Version to store with the credential.").Int()
+	cmdPut           = app.Command("put", "Put a credential into the store.")
+	cmdPutName       = cmdPut.Arg("credential", "The name of the credential to store.").HintAction(completeNames).Required().String()
+	cmdPutSecret     = cmdPut.Arg("value", "The value of the credential to store, or - to read it from stdin.").String()
+	cmdPutVersion    = cmdPut.Arg("version", "Version to store with the credential.").Int()
+	cmdPutPrompt     = cmdPut.Flag("prompt", "Prompt for the value without echoing it.").Bool()
+	cmdPutFields     = cmdPut.Flag("field", "Set a KEY=VALUE field of the latest JSON value, KEY can be a path such as db.password.").StringMap()
+	cmdPutTrim       = cmdPut.Flag("trim", "Strip the trailing newline from the value.").Bool()
+	cmdPutSkipPolicy = cmdPut.Flag("skip-policy", "Store the value even if it doesn't meet the password policy.").Bool()
 
 	cmdPutFile           = app.Command("put-file", "Put a credential from a file into the store.")
-	cmdPutFileName       = cmdPutFile.Arg("credential", "The name of the credential to store.").Required().String()
-	cmdPutFileSecretPath = cmdPutFile.Arg("value", "Path to file containing the credential to store.").Required().String()
+	cmdPutFileName       = cmdPutFile.Arg("credential", "The name of the credential to store.").HintAction(completeNames).Required().String()
+	cmdPutFileSecretPath = cmdPutFile.Arg("value", "Path to file containing the credential to store, or - to read it from stdin.").Required().String()
 	cmdPutFileVersion    = cmdPutFile.Arg("version", "Version to store with the credential.").Int()
+	cmdPutFileBinary     = cmdPutFile.Flag("binary", "Flag the file as binary content.").Bool()
+	cmdPutFileTrim       = cmdPutFile.Flag("trim", "Strip the trailing newline from the file.").Bool()
+	cmdPutFileSkipPolicy = cmdPutFile.Flag("skip-policy", "Store the file even if it doesn't meet the password policy.").Bool()
+
+	cmdHistory            = app.Command("history", "Show every version of a credential.")
+	cmdHistoryName        = cmdHistory.Arg("credential", "The name of the credential.").HintAction(completeNames).Required().String()
+	cmdHistoryFingerprint = cmdHistory.Flag("fingerprint", "Include a fingerprint of each value to spot identical versions.").Bool()
+
+	cmdRollback     = app.Command("rollback", "Copy a previous version of a credential forward as the latest version.")
+	cmdRollbackName = cmdRollback.Arg("credential", "The name of the credential to roll back.").HintAction(completeNames).Required().String()
+	cmdRollbackTo   = cmdRollback.Flag("to", "The version to roll back to, defaults to the version before the latest.").HintAction(completeVersions(cmdRollbackName)).Int()
 
 	cmdDelete     = app.Command("delete", "Delete a credential from the store.")
-	cmdDeleteName = cmdDelete.Arg("credential", "The name of the credential to delete.").Required().String()
+	cmdDeleteName = cmdDelete.Arg("credential", "The name of the credential to delete.").HintAction(completeNames).Required().String()
+
+	cmdDiff         = app.Command("diff", "Compare two versions of a credential, or the credentials in two stores.")
+	cmdDiffName     = cmdDiff.Arg("credential", "The name of the credential to compare.").HintAction(completeNames).String()
+	cmdDiffFrom     = cmdDiff.Arg("from", "The version to compare from.").HintAction(completeVersions(cmdDiffName)).Int()
+	cmdDiffTo       = cmdDiff.Arg("to", "The version to compare to, defaults to the latest.").HintAction(completeVersions(cmdDiffName)).Int()
+	cmdDiffShow     = cmdDiff.Flag("show", "Show the values rather than masking them.").Bool()
+	cmdDiffPrefix   = cmdDiff.Flag("prefix", "Only compare credentials whose names start with the prefix.").String()
+	cmdDiffToTable  = cmdDiff.Flag("to-table", "Compare with the credentials in another table.").String()
+	cmdDiffToRegion = cmdDiff.Flag("to-region", "Region of the table to compare with, defaults to the current region.").String()
+
+	cmdSync            = app.Command("sync", "Bring the store in line with the secrets listed in a manifest.")
+	cmdSyncManifest    = cmdSync.Arg("manifest", "Path to the YAML manifest of secrets.").Required().String()
+	cmdSyncApply       = cmdSync.Flag("apply", "Apply the planned changes rather than only printing them.").Bool()
+	cmdSyncDeleteExtra = cmdSync.Flag("delete-extra", "Delete secrets with the manifest prefix which aren't in the manifest.").Bool()
+	cmdSyncSkipPolicy  = cmdSync.Flag("skip-policy", "Store values even if they don't meet the password policy.").Bool()
+
+	cmdAuditDupes = app.Command("audit-dupes", "Report credentials which share the same value.")
+
+	cmdDoctor = app.Command("doctor", "Check the region, credentials, table and KMS key are configured correctly.")
+
+	cmdWatch            = app.Command("watch", "Watch credentials for changes, re-rendering files and signalling or restarting a command.")
+	cmdWatchPrefix      = cmdWatch.Flag("prefix", "Only watch credentials whose names start with the prefix.").String()
+	cmdWatchInterval    = cmdWatch.Flag("interval", "How often to check for new versions.").Default("60s").Duration()
+	cmdWatchTemplates   = cmdWatch.Flag("template", "Render a SRC:DEST text/template with the credentials, repeat for more templates.").Strings()
+	cmdWatchEnvFile     = cmdWatch.Flag("env-file", "Write the credentials to a file of NAME='value' lines.").String()
+	cmdWatchSignal      = cmdWatch.Flag("signal", "Send the command a signal on changes rather than restarting it.").Enum("HUP", "INT", "QUIT", "TERM", "USR1", "USR2")
+	cmdWatchStopTimeout = cmdWatch.Flag("stop-timeout", "How long to wait for the command to exit when restarting it before killing it.").Default("10s").Duration()
+	cmdWatchCommand     = cmdWatch.Arg("command", "The command to run with the credentials loaded as environment variables.").Strings()
 
-	cmdExecute        = app.Command("exec", "Execute a command with all secrets loaded as environment variables.")
-	cmdExecuteCommand = cmdExecute.Arg("command", "The command to execute.").Required().Strings()
+	cmdEvents       = app.Command("events", "Emit changes to credentials read from the table stream as JSON lines.")
+	cmdEventsPrefix = cmdEvents.Flag("prefix", "Only emit changes to credentials whose names start with the prefix.").String()
+
+	cmdCompletion      = app.Command("completion", "Output a shell completion script which completes credential names and versions.")
+	cmdCompletionShell = cmdCompletion.Arg("shell", "The shell to complete for.").Required().Enum("bash", "zsh", "fish")
+
+	cmdExecute           = app.Command("exec", "Execute a command with all secrets loaded as environment variables.")
+	cmdExecuteExpandJSON = cmdExecute.Flag("expand-json", "Also load each field of JSON secrets as NAME_FIELD environment variables.").Bool()
+	cmdExecuteCommand    = cmdExecute.Arg("command", "The command to execute.").Required().Strings()
 
 	// Version app version
 	Version = "1.0.0"
 )
 
+const defaultTable = "credential-store"
+
 func main() {
 	app.Version(Version)
 
@@ -80,86 +165,263 @@ func main() {
 		log.SetLevel(log.DebugLevel)
 	}
 
-	unicreds.SetAwsConfig(region, profile, role)
+	if command == cmdCompletion.FullCommand() {
+		printCompletionScript(*cmdCompletionShell)
+		return
+	}
+
+	config, roleOpts, err := configure()
+	if err != nil {
+		printFatalError(err)
+	}
+
+	unicreds.SetDecryptConcurrency(*concurrency)
+	unicreds.SetDataKeyCache(*dataKeyCacheTTL, *dataKeyCacheSize)
 
 	switch command {
 	case cmdSetup.FullCommand():
-		err := unicreds.Setup(dynamoTable, cmdSetupRead, cmdSetupWrite)
+		err := unicreds.SetupWithOptions(dynamoTable, cmdSetupRead, cmdSetupWrite, &unicreds.TableOptions{
+			BillingMode:         *cmdSetupBillingMode,
+			Tags:                *cmdSetupTags,
+			SSEKMSKeyID:         *cmdSetupSSEKey,
+			PointInTimeRecovery: *cmdSetupPointInTime,
+			DeletionProtection:  *cmdSetupDeletionProtection,
+			Streams:             *cmdSetupStreams,
+		})
 		if err != nil {
 			printFatalError(err)
 		}
-		log.WithFields(log.Fields{"status": "success"}).Info("Created table")
+		log.WithFields(log.Fields{"status": "success"}).Info("Setup table")
 	case cmdGet.FullCommand():
+		names, version := parseGetArgs(*cmdGetName, *cmdGetVersion)
+
+		if *cmdGetFingerprint && *fingerprintKey == "" {
+			printFatalError(fmt.Errorf("--fingerprint requires a KMS HMAC key set with --fingerprint-key or UNICREDS_FINGERPRINT_KEY"))
+		}
+
+		printEncryptionContext(encContext)
+
+		if len(names) > 1 {
+			if *cmdGetOut != "" {
+				printFatalError(fmt.Errorf("--out can only be used when getting a single credential"))
+			}
+
+			creds, missing, err := unicreds.GetSecrets(dynamoTable, names, encContext)
+			if err != nil {
+				printFatalError(err)
+			}
+
+			table := unicreds.NewTable(os.Stdout)
+			table.SetHeaders([]string{"Name", "Secret"})
+
+			if *csv {
+				table.SetFormat(unicreds.TableFormatCSV)
+			}
+
+			for _, name := range names {
+				cred, ok := creds[name]
+				if !ok {
+					continue
+				}
+				delete(creds, name) // only emit each name once
+
+				extractField(cred)
+
+				secret, encoding := encodeSecret(cred)
+
+				if *logJSON {
+					log.WithFields(log.Fields{"name": name, "secret": secret, "encoding": encoding, "status": "success"}).Info(secret)
+				} else {
+					table.Write([]string{name, secret})
+				}
+			}
+
+			if !*logJSON {
+				if err = table.Render(); err != nil {
+					printFatalError(err)
+				}
+			}
+
+			for _, name := range missing {
+				log.WithField("name", name).Error("missing")
+			}
+
+			if len(missing) > 0 {
+				printFatalError(unicreds.ErrSecretNotFound)
+			}
+
+			break
+		}
+
 		var cred *unicreds.DecryptedCredential
 		var err error
-		if *cmdGetVersion == 0 {
+		if version == 0 {
 			cred, err = unicreds.GetHighestVersionSecret(dynamoTable, *cmdGetName, encContext)
 		} else {
-			cred, err = unicreds.GetSecret(dynamoTable, *cmdGetName, unicreds.PaddedInt(*cmdGetVersion), encContext)
+			cred, err = unicreds.GetSecret(dynamoTable, *cmdGetName, unicreds.PaddedInt(version), encContext)
 		}
 		if err != nil {
 			printFatalError(err)
 		}
 
-		printEncryptionContext(encContext)
+		extractField(cred)
+
+		if *cmdGetOut != "" {
+			if err = ioutil.WriteFile(*cmdGetOut, []byte(cred.Secret), 0600); err != nil {
+				printFatalError(err)
+			}
+			log.WithFields(log.Fields{"name": *cmdGetName, "path": *cmdGetOut}).Debug("wrote secret")
+			break
+		}
+
+		secret, encoding := encodeSecret(cred)
 
 		if *logJSON {
-			log.WithFields(log.Fields{"name": *cmdGetName, "secret": cred.Secret, "status": "success"}).Info(cred.Secret)
+			log.WithFields(log.Fields{"name": *cmdGetName, "secret": secret, "encoding": encoding, "status": "success"}).Info(secret)
 		} else {
-			// Or just print, out of backwards compatibility
-			printSecret(cred.Secret, *cmdGetNoLine)
+			// Or just print, out of backwards compatibility, without adding a newline to raw binary content
+			printSecret(secret, *cmdGetNoLine || (cred.Binary && encoding == "text"))
 		}
 
 	case cmdPut.FullCommand():
-		version, err := unicreds.ResolveVersion(dynamoTable, *cmdPutName, *cmdPutVersion)
-		if err != nil {
-			printFatalError(err)
-		}
+		value, version := *cmdPutSecret, *cmdPutVersion
 
-		printEncryptionContext(encContext)
+		var secret []byte
+		var err error
+
+		if *cmdPutPrompt || len(*cmdPutFields) > 0 {
+			// a lone number following the name is the version
+			if v, perr := strconv.Atoi(value); perr == nil && version == 0 {
+				value, version = "", v
+			}
+			if value != "" {
+				printFatalError(fmt.Errorf("a value can't be supplied with --prompt or --field"))
+			}
+		}
 
-		err = unicreds.PutSecret(dynamoTable, *alias, *cmdPutName, *cmdPutSecret, version, encContext)
+		switch {
+		case *cmdPutPrompt:
+			secret, err = promptSecret(*cmdPutName)
+		case len(*cmdPutFields) > 0:
+			secret, err = updateFields(*cmdPutName, *cmdPutFields)
+		case value == "":
+			printFatalError(fmt.Errorf("a value, - to read stdin, or --prompt is required"))
+		case value == "-":
+			secret, err = readSecret(value)
+		default:
+			secret = []byte(value)
+		}
 		if err != nil {
 			printFatalError(err)
 		}
-		log.WithFields(log.Fields{"name": *cmdPutName, "version": version}).Info("stored")
+
+		storeSecret(config, *cmdPutName, secret, version, *cmdPutTrim, *cmdPutSkipPolicy, putOptions())
 	case cmdPutFile.FullCommand():
-		version, err := unicreds.ResolveVersion(dynamoTable, *cmdPutFileName, *cmdPutFileVersion)
+		secret, err := readSecret(*cmdPutFileSecretPath)
 		if err != nil {
 			printFatalError(err)
 		}
 
-		printEncryptionContext(encContext)
+		opts := putOptions()
+		opts.Binary = *cmdPutFileBinary
 
-		data, err := ioutil.ReadFile(*cmdPutFileSecretPath)
+		storeSecret(config, *cmdPutFileName, secret, *cmdPutFileVersion, *cmdPutFileTrim, *cmdPutFileSkipPolicy, opts)
+	case cmdList.FullCommand():
+		creds, err := unicreds.ListSecrets(dynamoTable, *cmdListAllVersions)
 		if err != nil {
 			printFatalError(err)
 		}
 
-		err = unicreds.PutSecret(dynamoTable, *alias, *cmdPutFileName, string(data), version, encContext)
-		if err != nil {
+		headers := []string{"Name", "Version", "Created-At"}
+		if *cmdListLong {
+			headers = append(headers, "Encryption-Context")
+		}
+
+		table := unicreds.NewTable(os.Stdout)
+		table.SetHeaders(headers)
+
+		if *csv {
+			table.SetFormat(unicreds.TableFormatCSV)
+		}
+
+		for _, cred := range creds {
+			row := []string{cred.Name, cred.Version, cred.CreatedAtDate()}
+			if *cmdListLong {
+				row = append(row, storedContext(cred))
+			}
+			table.Write(row)
+		}
+		if err = table.Render(); err != nil {
 			printFatalError(err)
 		}
-		log.WithFields(log.Fields{"name": *cmdPutFileName, "version": version}).Info("stored")
-	case cmdList.FullCommand():
-		creds, err := unicreds.ListSecrets(dynamoTable, *cmdListAllVersions)
+	case cmdHistory.FullCommand():
+		printEncryptionContext(encContext)
+
+		versions, err := unicreds.GetSecretHistory(dynamoTable, *cmdHistoryName, *cmdHistoryFingerprint, encContext)
 		if err != nil {
 			printFatalError(err)
 		}
 
+		var fingerprint unicreds.Fingerprinter
+		headers := []string{"Version", "Created-At", "KMS-Key", "Size"}
+
+		if *cmdHistoryFingerprint {
+			fingerprint = newFingerprinter()
+			headers = append(headers, "Fingerprint")
+		}
+
 		table := unicreds.NewTable(os.Stdout)
-		table.SetHeaders([]string{"Name", "Version", "Created-At"})
+		table.SetHeaders(headers)
 
 		if *csv {
 			table.SetFormat(unicreds.TableFormatCSV)
 		}
 
-		for _, cred := range creds {
-			table.Write([]string{cred.Name, cred.Version, cred.CreatedAtDate()})
+		for _, version := range versions {
+			row := []string{version.Version, version.CreatedAtDate(), version.KeyArn, strconv.Itoa(version.Size)}
+			if fingerprint != nil {
+				fp := "unreadable"
+				if version.Err == nil {
+					if fp, err = fingerprint(version.Secret); err != nil {
+						printFatalError(err)
+					}
+				} else {
+					log.WithFields(log.Fields{"version": version.Version, "err": version.Err}).Warn("unable to decrypt version")
+				}
+				row = append(row, fp)
+			}
+
+			if *logJSON {
+				fields := log.Fields{"name": version.Name, "version": version.Version, "created_at": version.CreatedAt, "kms_key": version.KeyArn, "size": version.Size}
+				if version.RolledBackFrom != "" {
+					fields["rolled_back_from"] = version.RolledBackFrom
+				}
+				if fingerprint != nil {
+					fields["fingerprint"] = row[4]
+				}
+				log.WithFields(fields).Info(version.Version)
+			} else {
+				table.Write(row)
+			}
 		}
-		if err = table.Render(); err != nil {
+
+		if !*logJSON {
+			if err = table.Render(); err != nil {
+				printFatalError(err)
+			}
+		}
+	case cmdRollback.FullCommand():
+		printEncryptionContext(encContext)
+
+		if err = config.CheckEncryptionContext(*cmdRollbackName, encContext); err != nil {
 			printFatalError(err)
 		}
+
+		from, version, err := unicreds.Rollback(dynamoTable, *alias, *cmdRollbackName, *cmdRollbackTo, encContext)
+		if err != nil {
+			printFatalError(err)
+		}
+		log.WithFields(log.Fields{"name": *cmdRollbackName, "from": from, "version": version}).Info("rolled back")
 	case cmdGetAll.FullCommand():
 		creds, err := unicreds.GetAllSecrets(dynamoTable, *cmdGetAllVersions, encContext)
 		if err != nil {
@@ -185,6 +447,217 @@ func main() {
 		if err != nil {
 			printFatalError(err)
 		}
+	case cmdDiff.FullCommand():
+		printEncryptionContext(encContext)
+
+		if *cmdDiffToTable != "" || *cmdDiffToRegion != "" {
+			from, err := unicreds.GetAllSecrets(dynamoTable, false, encContext)
+			if err != nil {
+				printFatalError(err)
+			}
+
+			fromListed, err := unicreds.ListSecrets(dynamoTable, false)
+			if err != nil {
+				printFatalError(err)
+			}
+
+			if *cmdDiffToRegion != "" {
+				err = unicreds.SetAwsConfigWithRoles(cmdDiffToRegion, profile, *role, roleOpts)
+				if err != nil {
+					printFatalError(err)
+				}
+			}
+
+			toTable := *dynamoTable
+			if *cmdDiffToTable != "" {
+				toTable = *cmdDiffToTable
+			}
+
+			to, err := unicreds.GetAllSecrets(&toTable, false, encContext)
+			if err != nil {
+				printFatalError(err)
+			}
+
+			toListed, err := unicreds.ListSecrets(&toTable, false)
+			if err != nil {
+				printFatalError(err)
+			}
+
+			printSecretsDiff(unicreds.DiffSecrets(from, to, fromListed, toListed, *cmdDiffPrefix))
+			break
+		}
+
+		if *cmdDiffName == "" || *cmdDiffFrom == 0 {
+			printFatalError(fmt.Errorf("a credential and version, or --to-table or --to-region, are required"))
+		}
+
+		from, err := unicreds.GetSecret(dynamoTable, *cmdDiffName, unicreds.PaddedInt(*cmdDiffFrom), encContext)
+		if err != nil {
+			printFatalError(err)
+		}
+
+		var to *unicreds.DecryptedCredential
+		if *cmdDiffTo == 0 {
+			to, err = unicreds.GetHighestVersionSecret(dynamoTable, *cmdDiffName, encContext)
+		} else {
+			to, err = unicreds.GetSecret(dynamoTable, *cmdDiffName, unicreds.PaddedInt(*cmdDiffTo), encContext)
+		}
+		if err != nil {
+			printFatalError(err)
+		}
+
+		diff, err := unicreds.DiffValues(from.Secret, to.Secret,
+			*cmdDiffName+"@"+from.Version, *cmdDiffName+"@"+to.Version, *cmdDiffShow)
+		if err != nil {
+			printFatalError(err)
+		}
+
+		if *logJSON {
+			log.WithFields(log.Fields{"name": *cmdDiffName, "from": from.Version, "to": to.Version, "changed": diff != "", "diff": diff}).Info("diff")
+		} else if diff == "" {
+			log.WithFields(log.Fields{"from": from.Version, "to": to.Version}).Info("no differences")
+		} else {
+			fmt.Print(diff)
+		}
+	case cmdSync.FullCommand():
+		manifest, err := unicreds.LoadManifest(*cmdSyncManifest)
+		if err != nil {
+			printFatalError(err)
+		}
+
+		printEncryptionContext(encContext)
+
+		changes, err := unicreds.PlanSync(dynamoTable, manifest, *cmdSyncDeleteExtra, encContext)
+		if err != nil {
+			printFatalError(err)
+		}
+
+		table := unicreds.NewTable(os.Stdout)
+		table.SetHeaders([]string{"Name", "Action"})
+
+		if *csv {
+			table.SetFormat(unicreds.TableFormatCSV)
+		}
+
+		pending := 0
+		for _, change := range changes {
+			if change.Action != unicreds.SyncUnchanged {
+				pending++
+			}
+
+			if change.Action == unicreds.SyncCreate || change.Action == unicreds.SyncUpdate {
+				if err = config.CheckEncryptionContext(change.Name, encContext); err != nil {
+					printFatalError(err)
+				}
+				checkPasswordPolicy(config, change.Name, change.Secret(), change.Binary(), *cmdSyncSkipPolicy)
+			}
+
+			if *logJSON {
+				log.WithFields(log.Fields{"name": change.Name, "action": change.Action}).Info(change.Action)
+			} else {
+				table.Write([]string{change.Name, change.Action})
+			}
+		}
+
+		if !*logJSON {
+			if err = table.Render(); err != nil {
+				printFatalError(err)
+			}
+		}
+
+		if pending == 0 {
+			log.Info("no changes")
+			break
+		}
+
+		if !*cmdSyncApply {
+			log.WithField("changes", pending).Info("run with --apply to make these changes")
+			break
+		}
+
+		if err = unicreds.ApplySync(dynamoTable, *alias, changes, encContext, putOptions()); err != nil {
+			printFatalError(err)
+		}
+		log.WithField("changes", pending).Info("applied")
+	case cmdAuditDupes.FullCommand():
+		printEncryptionContext(encContext)
+
+		creds, err := unicreds.GetAllSecrets(dynamoTable, false, encContext)
+		if err != nil {
+			printFatalError(err)
+		}
+
+		groups, err := unicreds.FindDuplicates(creds, newFingerprinter())
+		if err != nil {
+			printFatalError(err)
+		}
+
+		if len(groups) == 0 {
+			log.WithField("checked", len(creds)).Info("no duplicates")
+			break
+		}
+
+		table := unicreds.NewTable(os.Stdout)
+		table.SetHeaders([]string{"Fingerprint", "Names"})
+
+		if *csv {
+			table.SetFormat(unicreds.TableFormatCSV)
+		}
+
+		for _, group := range groups {
+			if *logJSON {
+				log.WithFields(log.Fields{"fingerprint": group.Fingerprint, "names": group.Names}).Info("duplicate")
+			} else {
+				table.Write([]string{group.Fingerprint, strings.Join(group.Names, ", ")})
+			}
+		}
+
+		if !*logJSON {
+			if err = table.Render(); err != nil {
+				printFatalError(err)
+			}
+		}
+	case cmdDoctor.FullCommand():
+		printEncryptionContext(encContext)
+
+		checks := unicreds.Doctor(dynamoTable, *alias, encContext)
+
+		table := unicreds.NewTable(os.Stdout)
+		table.SetHeaders([]string{"Check", "Status", "Detail", "Hint"})
+
+		if *csv {
+			table.SetFormat(unicreds.TableFormatCSV)
+		}
+
+		var failed []*unicreds.DoctorCheck
+
+		for _, check := range checks {
+			status := "PASS"
+			if !check.Passed {
+				status = "FAIL"
+				failed = append(failed, check)
+			}
+
+			if *logJSON {
+				log.WithFields(log.Fields{"check": check.Name, "status": status, "detail": check.Detail, "hint": check.Hint}).Info(check.Name)
+			} else {
+				table.Write([]string{check.Name, status, check.Detail, check.Hint})
+			}
+		}
+
+		if !*logJSON {
+			if err := table.Render(); err != nil {
+				printFatalError(err)
+			}
+		}
+
+		if len(failed) > 0 {
+			printFatalError(&unicreds.DoctorError{Failed: failed})
+		}
+	case cmdWatch.FullCommand():
+		runWatch()
+	case cmdEvents.FullCommand():
+		runEvents()
 	case cmdExecute.FullCommand():
 		args := []string(*cmdExecuteCommand)
 		commandPath, err := exec.LookPath(args[0])
@@ -195,6 +668,11 @@ func main() {
 		for _, cred := range creds {
 			os.Setenv(cred.Name, cred.Secret)
 		}
+		if *cmdExecuteExpandJSON {
+			for name, value := range expandedSecrets(creds) {
+				os.Setenv(name, value)
+			}
+		}
 		err = syscall.Exec(commandPath, args, os.Environ())
 		if err != nil {
 			printFatalError(err)
@@ -202,9 +680,168 @@ func main() {
 	}
 }
 
+// expandedSecrets the fields of each JSON secret as environment variables, a field which would
+// replace a secret of the same name, or a field already expanded from another secret, is
+// skipped with a warning
+func expandedSecrets(creds []*unicreds.DecryptedCredential) map[string]string {
+	names := map[string]bool{}
+	for _, cred := range creds {
+		names[cred.Name] = true
+	}
+
+	expanded := map[string]string{}
+	from := map[string]string{}
+
+	for _, cred := range creds {
+		vars, _ := unicreds.ExpandJSON(cred.Name, cred.Secret)
+
+		fields := make([]string, 0, len(vars))
+		for name := range vars {
+			fields = append(fields, name)
+		}
+		sort.Strings(fields)
+
+		for _, name := range fields {
+			if names[name] {
+				log.WithFields(log.Fields{"name": name, "secret": cred.Name}).Warn("Not expanding field over a secret of the same name")
+				continue
+			}
+			if other, ok := from[name]; ok {
+				log.WithFields(log.Fields{"name": name, "secret": cred.Name, "expanded_from": other}).Warn("Not expanding field already expanded from another secret")
+				continue
+			}
+			expanded[name] = vars[name]
+			from[name] = cred.Name
+		}
+	}
+
+	return expanded
+}
+
+// configure load the config files and encryption context, apply the named environment and
+// defaults, then set up the AWS session
+func configure() (*unicreds.Config, *unicreds.RoleOptions, error) {
+	config, err := unicreds.LoadConfig(unicreds.DefaultConfigPaths()...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = loadEncryptionContext(); err != nil {
+		return nil, nil, err
+	}
+
+	if *envName != "" {
+		if err = applyEnvironment(config, *envName); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if *dynamoTable == "" {
+		*dynamoTable = defaultTable
+	}
+
+	if *alias == "" {
+		*alias = unicreds.DefaultKmsKey
+	}
+
+	roleOpts := &unicreds.RoleOptions{
+		ExternalID:    *roleExternalID,
+		MFASerial:     *roleMFASerial,
+		MFAToken:      *roleMFAToken,
+		TokenProvider: promptMFAToken,
+		SessionName:   *roleSessionName,
+		Duration:      *roleDuration,
+	}
+
+	if err = unicreds.SetAwsConfigWithRoles(region, profile, *role, roleOpts); err != nil {
+		return nil, nil, err
+	}
+
+	hooks, err := config.NotificationHooks(*envName)
+	if err != nil {
+		return nil, nil, err
+	}
+	unicreds.SetNotificationHooks(hooks)
+
+	return config, roleOpts, nil
+}
+
+// applyEnvironment fill in any settings which weren't supplied as flags or UNICREDS_*
+// environment variables from the named environment in the config files
+func applyEnvironment(config *unicreds.Config, name string) error {
+	env, err := config.Environment(name)
+	if err != nil {
+		return err
+	}
+
+	log.WithField("env", name).Debug("Applying environment")
+
+	for target, value := range map[*string]string{
+		region:      env.Region,
+		profile:     env.Profile,
+		dynamoTable: env.Table,
+		alias:       env.Alias,
+	} {
+		if *target == "" {
+			*target = value
+		}
+	}
+
+	if len(*role) == 0 {
+		*role = env.AllRoles()
+	}
+
+	// pairs supplied with -E, a file or UNICREDS_ENC_CONTEXT take precedence
+	envContext := unicreds.NewEncryptionContextValue()
+	envContext.SetMap(env.EncryptionContext)
+	encContext.Merge(envContext)
+
+	return nil
+}
+
+// loadEncryptionContext add pairs from --enc-context-file then UNICREDS_ENC_CONTEXT, neither
+// overrides pairs supplied with -E
+func loadEncryptionContext() error {
+	if *encFile != "" {
+		fileContext := unicreds.NewEncryptionContextValue()
+		if err := fileContext.LoadFile(*encFile); err != nil {
+			return err
+		}
+		encContext.Merge(fileContext)
+	}
+
+	if value := os.Getenv("UNICREDS_ENC_CONTEXT"); value != "" {
+		envContext := unicreds.NewEncryptionContextValue()
+		if err := envContext.Parse(value); err != nil {
+			return err
+		}
+		encContext.Merge(envContext)
+	}
+
+	return nil
+}
+
+// printFatalError log the error and exit with the code for the kind of failure, JSON output also
+// includes the stable error code so scripts don't need to parse the message
 func printFatalError(err error) {
-	log.WithError(err).Error("failed")
-	os.Exit(1)
+	entry := log.WithError(err)
+	if *logJSON {
+		entry = entry.WithFields(log.Fields{"code": unicreds.ErrorCode(err), "exit_code": unicreds.ExitCode(err)})
+	}
+	entry.Error("failed")
+	os.Exit(unicreds.ExitCode(err))
+}
+
+// parseGetArgs a single numeric argument after the name is a version, anything
+// else is treated as further names to fetch
+func parseGetArgs(name string, args []string) ([]string, int) {
+	if len(args) == 1 {
+		if version, err := strconv.Atoi(args[0]); err == nil {
+			return []string{name}, version
+		}
+	}
+
+	return append([]string{name}, args...), 0
 }
 
 func printSecret(secret string, noline bool) {
@@ -216,6 +853,259 @@ func printSecret(secret string, noline bool) {
 	}
 }
 
+// encodeSecret fingerprint or base64 encode the secret when requested, or base64 encode binary
+// content emitted as JSON, returning the secret and its encoding
+func encodeSecret(cred *unicreds.DecryptedCredential) (string, string) {
+	if *cmdGetFingerprint {
+		fp, err := newFingerprinter()(cred.Secret)
+		if err != nil {
+			printFatalError(err)
+		}
+		return fp, "fingerprint"
+	}
+	if *cmdGetBase64 || (cred.Binary && *logJSON) {
+		return base64.StdEncoding.EncodeToString([]byte(cred.Secret)), "base64"
+	}
+	return cred.Secret, "text"
+}
+
+// newFingerprinter use the KMS fingerprint key if one is configured, otherwise a random key
+// which makes fingerprints which can only be compared within this run
+func newFingerprinter() unicreds.Fingerprinter {
+	if *fingerprintKey != "" {
+		return unicreds.KMSFingerprinter(*fingerprintKey)
+	}
+
+	key, err := unicreds.NewFingerprintKey()
+	if err != nil {
+		printFatalError(err)
+	}
+	return unicreds.KeyFingerprinter(key)
+}
+
+// printSecretsDiff list the names which differ between two stores
+func printSecretsDiff(diff *unicreds.SecretsDiff) {
+	table := unicreds.NewTable(os.Stdout)
+	table.SetHeaders([]string{"Name", "Change"})
+
+	if *csv {
+		table.SetFormat(unicreds.TableFormatCSV)
+	}
+
+	changes := []struct {
+		change string
+		names  []string
+	}{
+		{"added", diff.Added},
+		{"removed", diff.Removed},
+		{"changed", diff.Changed},
+		{"unreadable", diff.Unreadable},
+	}
+
+	for _, c := range changes {
+		for _, name := range c.names {
+			if *logJSON {
+				log.WithFields(log.Fields{"name": name, "change": c.change}).Info(c.change)
+			} else {
+				table.Write([]string{name, c.change})
+			}
+		}
+	}
+
+	if *logJSON {
+		return
+	}
+
+	if diff.Empty() {
+		log.WithField("unchanged", len(diff.Unchanged)).Info("no differences")
+		return
+	}
+
+	if err := table.Render(); err != nil {
+		printFatalError(err)
+	}
+}
+
+// storeSecret put the secret into the store, exiting on failure
+func storeSecret(config *unicreds.Config, name string, secret []byte, ver int, trim, skipPolicy bool, opts *unicreds.PutOptions) {
+	version, err := unicreds.ResolveVersion(dynamoTable, name, ver)
+	if err != nil {
+		printFatalError(err)
+	}
+
+	printEncryptionContext(encContext)
+
+	if err = config.CheckEncryptionContext(name, encContext); err != nil {
+		printFatalError(err)
+	}
+
+	if trim {
+		secret = bytes.TrimSuffix(bytes.TrimSuffix(secret, []byte("\n")), []byte("\r"))
+	}
+
+	checkPasswordPolicy(config, name, string(secret), opts.Binary, skipPolicy)
+
+	err = unicreds.PutSecretWithOptions(dynamoTable, *alias, name, string(secret), version, encContext, opts)
+	if err != nil {
+		printFatalError(err)
+	}
+	log.WithFields(log.Fields{"name": name, "version": version}).Info("stored")
+}
+
+// checkPasswordPolicy exit if the secret doesn't meet the password policy for its name, unless
+// the policy is skipped which is logged along with who skipped it
+func checkPasswordPolicy(config *unicreds.Config, name, secret string, binary, skip bool) {
+	if binary {
+		log.WithField("name", name).Debug("Password policy doesn't apply to binary content")
+		return
+	}
+
+	err := config.CheckPasswordPolicy(name, secret)
+	if err == nil {
+		return
+	}
+
+	// --skip-policy only overrides violations, not failures to load the policy
+	pe, ok := err.(*unicreds.PasswordPolicyError)
+	if !ok || !skip {
+		printFatalError(err)
+	}
+
+	caller, cerr := unicreds.GetCallerIdentity()
+	if cerr != nil {
+		caller = "unknown"
+		log.WithField("err", cerr).Debug("GetCallerIdentity failed")
+	}
+
+	log.WithFields(log.Fields{"name": name, "caller": caller, "violations": pe.Violations}).Warn("password policy skipped")
+}
+
+// extractField replace the secret with the requested field of its JSON value
+func extractField(cred *unicreds.DecryptedCredential) {
+	if *cmdGetField == "" {
+		return
+	}
+
+	value, err := unicreds.ExtractField(cred.Secret, *cmdGetField)
+	if err != nil {
+		printFatalError(fmt.Errorf("%s: %v", cred.Name, err))
+	}
+	cred.Secret = value
+}
+
+// updateFields set fields of the latest JSON value of the secret, starting from an empty
+// object if it doesn't exist yet
+func updateFields(name string, fields map[string]string) ([]byte, error) {
+	var secret string
+
+	cred, err := unicreds.GetHighestVersionSecret(dynamoTable, name, encContext)
+	switch err {
+	case nil:
+		secret = cred.Secret
+
+		// keep the encryption context the latest version was stored with
+		if len(*encContext) == 0 && len(cred.Context) > 0 {
+			encContext.SetMap(cred.Context)
+		}
+	case unicreds.ErrSecretNotFound:
+	default:
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if secret, err = unicreds.SetField(secret, key, fields[key]); err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+	}
+
+	return []byte(secret), nil
+}
+
+// readSecret read the secret from a file, or stdin if the path is -
+func readSecret(path string) ([]byte, error) {
+	if path == "-" {
+		return ioutil.ReadAll(os.Stdin)
+	}
+	return ioutil.ReadFile(path)
+}
+
+// promptSecret ask for the secret twice on the terminal without echoing it
+func promptSecret(name string) ([]byte, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return nil, fmt.Errorf("--prompt requires a terminal")
+	}
+
+	secret, err := promptHidden(fmt.Sprintf("Value for %s: ", name))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("no value entered")
+	}
+
+	confirm, err := promptHidden(fmt.Sprintf("Confirm value for %s: ", name))
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(secret, confirm) {
+		return nil, fmt.Errorf("values don't match")
+	}
+
+	return secret, nil
+}
+
+// promptMFAToken ask for the MFA token code on the terminal, stdin may be carrying a secret
+// so it isn't read otherwise
+func promptMFAToken() (string, error) {
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return "", fmt.Errorf("--mfa-token is required when stdin isn't a terminal")
+	}
+
+	token, err := promptHidden("MFA token code: ")
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(token)), nil
+}
+
+// promptHidden print the prompt on stderr and read a line from the terminal without echoing it
+func promptHidden(prompt string) ([]byte, error) {
+	fmt.Fprint(os.Stderr, prompt)
+	value, err := term.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Fprintln(os.Stderr)
+	return value, err
+}
+
+// putOptions settings applied when writing secrets
+func putOptions() *unicreds.PutOptions {
+	return &unicreds.PutOptions{
+		RecordContext:       *recordContext == "keys",
+		RecordContextValues: *recordContext == "values",
+	}
+}
+
+// storedContext format the encryption context recorded with the credential
+func storedContext(cred *unicreds.Credential) string {
+	pairs := make([]string, len(cred.ContextKeys))
+	for i, key := range cred.ContextKeys {
+		pairs[i] = key
+		if value, ok := cred.Context[key]; ok {
+			pairs[i] = key + ":" + value
+		}
+	}
+	return strings.Join(pairs, ",")
+}
+
 func printEncryptionContext(encContext *unicreds.EncryptionContextValue) {
 	if encContext == nil || len(*encContext) == 0 {
 		return
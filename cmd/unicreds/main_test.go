@@ -0,0 +1,23 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/versent/unicreds"
+)
+
+func TestExpandedSecrets(t *testing.T) {
+
+	creds := []*unicreds.DecryptedCredential{
+		{Credential: &unicreds.Credential{Name: "db"}, Secret: `{"password":"expanded","host":"db.local"}`},
+		{Credential: &unicreds.Credential{Name: "db_password"}, Secret: "real"},
+		{Credential: &unicreds.Credential{Name: "db_host"}, Secret: `{"port":5432}`},
+		{Credential: &unicreds.Credential{Name: "db"}, Secret: `{"host_port":"other"}`},
+		{Credential: &unicreds.Credential{Name: "plain"}, Secret: "not json"},
+	}
+
+	expanded := expandedSecrets(creds)
+
+	assert.Equal(t, map[string]string{"db_host_port": "5432"}, expanded)
+}
@@ -0,0 +1,295 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/apex/log"
+	"github.com/versent/unicreds"
+)
+
+var watchSignals = map[string]syscall.Signal{
+	"HUP":  syscall.SIGHUP,
+	"INT":  syscall.SIGINT,
+	"QUIT": syscall.SIGQUIT,
+	"TERM": syscall.SIGTERM,
+	"USR1": syscall.SIGUSR1,
+	"USR2": syscall.SIGUSR2,
+}
+
+// child a running copy of the supervised command
+type child struct {
+	cmd     *exec.Cmd
+	exited  chan struct{}
+	stopped int32
+}
+
+// supervisor runs the command with the secrets in its environment, and signals or restarts
+// it when they change
+type supervisor struct {
+	args        []string
+	signal      string
+	stopTimeout time.Duration
+
+	child *child
+	// done receives the result of the command exiting by itself
+	done chan error
+}
+
+func (s *supervisor) start(env []string) error {
+	cmd := exec.Command(s.args[0], s.args[1:]...)
+	cmd.Env = env
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	c := &child{cmd: cmd, exited: make(chan struct{})}
+	go func() {
+		err := cmd.Wait()
+		close(c.exited)
+		if atomic.LoadInt32(&c.stopped) == 0 {
+			s.done <- err
+		}
+	}()
+	s.child = c
+
+	log.WithField("pid", cmd.Process.Pid).Info("Started command")
+
+	return nil
+}
+
+// stop terminate the command, killing it if it doesn't exit within the stop timeout
+func (s *supervisor) stop() {
+	c := s.child
+	if c == nil {
+		return
+	}
+	s.child = nil
+
+	atomic.StoreInt32(&c.stopped, 1)
+	c.cmd.Process.Signal(syscall.SIGTERM)
+
+	select {
+	case <-c.exited:
+	case <-time.After(s.stopTimeout):
+		log.WithField("pid", c.cmd.Process.Pid).Warn("Killing command")
+		c.cmd.Process.Kill()
+		<-c.exited
+	}
+}
+
+// changed signal the command if a signal was requested, otherwise restart it with the new secrets
+func (s *supervisor) changed(env []string) error {
+	if s.child == nil {
+		return s.start(env)
+	}
+
+	if s.signal != "" {
+		log.WithFields(log.Fields{"pid": s.child.cmd.Process.Pid, "signal": s.signal}).Info("Signalling command")
+		return s.child.cmd.Process.Signal(watchSignals[s.signal])
+	}
+
+	log.WithField("pid", s.child.cmd.Process.Pid).Info("Restarting command")
+	s.stop()
+
+	return s.start(env)
+}
+
+// runWatch poll for changes to the secrets with the prefix, re-rendering templates and the env
+// file and signalling or restarting the command each time they change
+func runWatch() {
+	prefix := *cmdWatchPrefix
+
+	if *cmdWatchInterval <= 0 {
+		printFatalError(fmt.Errorf("--interval must be greater than zero"))
+	}
+
+	templates := map[string]string{}
+	for _, template := range *cmdWatchTemplates {
+		parts := strings.SplitN(template, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			printFatalError(fmt.Errorf("template %q must be in the form SRC:DEST", template))
+		}
+		templates[parts[0]] = parts[1]
+	}
+
+	var sup *supervisor
+	if len(*cmdWatchCommand) > 0 {
+		sup = &supervisor{
+			args:        *cmdWatchCommand,
+			signal:      *cmdWatchSignal,
+			stopTimeout: *cmdWatchStopTimeout,
+			done:        make(chan error, 1),
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rendered := false
+
+	handler := func(changed []string) error {
+		secrets, err := watchedSecrets(prefix)
+		if err == nil {
+			err = renderWatched(secrets, templates)
+		}
+		if err != nil {
+			// keep the last good output once running, the store may be briefly unavailable
+			if rendered {
+				log.WithError(err).Warn("Failed to refresh secrets")
+				return nil
+			}
+			return err
+		}
+		rendered = true
+
+		log.WithFields(log.Fields{"prefix": prefix, "changed": strings.Join(changed, ",")}).Info("Refreshed secrets")
+
+		if sup == nil {
+			return nil
+		}
+
+		env := os.Environ()
+		for name, value := range secrets {
+			env = append(env, unicreds.EnvName(prefix, name)+"="+value)
+		}
+
+		return sup.changed(env)
+	}
+
+	// changes read from the table stream are picked up straight away, polling continues in case
+	// the stream falls behind
+	trigger := make(chan struct{}, 1)
+	go func() {
+		err := unicreds.Subscribe(ctx, dynamoTable, func(event *unicreds.SecretEvent) error {
+			if strings.HasPrefix(event.Name, prefix) {
+				select {
+				case trigger <- struct{}{}:
+				default:
+				}
+			}
+			return nil
+		})
+		if err == unicreds.ErrStreamsDisabled {
+			log.Debug("Streams aren't enabled, polling for changes")
+		} else if err != nil {
+			log.WithError(err).Warn("Failed to read the table stream, polling for changes")
+		}
+	}()
+
+	watched := make(chan error, 1)
+	go func() {
+		watched <- unicreds.WatchTriggered(ctx, dynamoTable, prefix, *cmdWatchInterval, trigger, handler)
+	}()
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+
+	var done chan error
+	if sup != nil {
+		done = sup.done
+	}
+
+	select {
+	case err := <-watched:
+		if sup != nil {
+			sup.stop()
+		}
+		if err != nil {
+			printFatalError(err)
+		}
+	case err := <-done:
+		// the watch may have restarted the command as it exited, so stop any replacement
+		stopWatch(sup, cancel, watched)
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			os.Exit(exitErr.ExitCode())
+		}
+		if err != nil {
+			printFatalError(err)
+		}
+	case sig := <-sigs:
+		log.WithField("signal", sig.String()).Info("Stopping")
+		stopWatch(sup, cancel, watched)
+	}
+}
+
+// stopWatch cancel the watch and wait for it to return before stopping the command, so a
+// command started by a change being handled at the time isn't left running
+func stopWatch(sup *supervisor, cancel context.CancelFunc, watched <-chan error) {
+	cancel()
+	<-watched
+	if sup != nil {
+		sup.stop()
+	}
+}
+
+// watchedSecrets decrypt the latest version of each secret with the prefix
+func watchedSecrets(prefix string) (map[string]string, error) {
+	versions, err := unicreds.LatestVersions(dynamoTable, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	creds, _, err := unicreds.GetSecretVersions(dynamoTable, versions, encContext)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := make(map[string]string, len(creds))
+	for name, cred := range creds {
+		secrets[name] = cred.Secret
+	}
+
+	return secrets, nil
+}
+
+func renderWatched(secrets map[string]string, templates map[string]string) error {
+	for src, dest := range templates {
+		if err := unicreds.RenderTemplate(src, dest, secrets); err != nil {
+			return fmt.Errorf("%s: %v", src, err)
+		}
+	}
+
+	if *cmdWatchEnvFile != "" {
+		return unicreds.WriteEnvFile(*cmdWatchEnvFile, *cmdWatchPrefix, secrets)
+	}
+
+	return nil
+}
+
+// runEvents emit a JSON line for each change read from the table stream until interrupted
+func runEvents() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigs
+		cancel()
+	}()
+
+	enc := json.NewEncoder(os.Stdout)
+
+	err := unicreds.Subscribe(ctx, dynamoTable, func(event *unicreds.SecretEvent) error {
+		if !strings.HasPrefix(event.Name, *cmdEventsPrefix) {
+			return nil
+		}
+		return enc.Encode(event)
+	})
+	if err != nil {
+		printFatalError(err)
+	}
+}
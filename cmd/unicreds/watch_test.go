@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStopWatchAfterExitDuringRestart(t *testing.T) {
+
+	// the first copy exits straight away, the restarted copy keeps running until stopped
+	sup := &supervisor{
+		args:        []string{"sh", "-c", `if [ -n "$RESTARTED" ]; then exec sleep 30; fi; exit 3`},
+		stopTimeout: 5 * time.Second,
+		done:        make(chan error, 1),
+	}
+
+	assert.Nil(t, sup.start(os.Environ()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	restart := make(chan struct{})
+	replaced := make(chan *child, 1)
+	watched := make(chan error, 1)
+
+	// stands in for the watch handling a change just as the command exits
+	go func() {
+		<-restart
+		err := sup.changed(append(os.Environ(), "RESTARTED=1"))
+		replaced <- sup.child
+		<-ctx.Done()
+		watched <- err
+	}()
+
+	err := <-sup.done
+	if exitErr, ok := err.(*exec.ExitError); assert.True(t, ok) {
+		assert.Equal(t, 3, exitErr.ExitCode())
+	}
+
+	close(restart)
+	stopWatch(sup, cancel, watched)
+
+	c := <-replaced
+	if assert.NotNil(t, c) {
+		select {
+		case <-c.exited:
+		default:
+			t.Fatal("restarted command is still running")
+		}
+	}
+	assert.Nil(t, sup.child)
+}
+
+func TestStopWatchWithoutCommand(t *testing.T) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	watched := make(chan error, 1)
+	go func() {
+		<-ctx.Done()
+		watched <- nil
+	}()
+
+	stopWatch(nil, cancel, watched)
+
+	assert.NotNil(t, ctx.Err())
+}
@@ -0,0 +1,282 @@
+package unicreds
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/apex/log"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// ConfigFileName name of the unicreds config file in the home and project directories
+const ConfigFileName = ".unicreds.yaml"
+
+// Environment named bundle of settings from the config file
+type Environment struct {
+	Region            string            `yaml:"region"`
+	Profile           string            `yaml:"profile"`
+	Role              string            `yaml:"role"`
+	Roles             []string          `yaml:"roles"`
+	Table             string            `yaml:"table"`
+	Alias             string            `yaml:"alias"`
+	EncryptionContext map[string]string `yaml:"encryption_context"`
+
+	// Notifications hooks which are only used when the environment is selected
+	Notifications []*NotificationHook `yaml:"notifications"`
+}
+
+// AllRoles returns the role followed by any chained roles
+func (e *Environment) AllRoles() []string {
+	var roles []string
+	if e.Role != "" {
+		roles = append(roles, e.Role)
+	}
+	return append(roles, e.Roles...)
+}
+
+// merge overlay any settings present in other onto this environment
+func (e *Environment) merge(other *Environment) {
+	if other.Region != "" {
+		e.Region = other.Region
+	}
+	if other.Profile != "" {
+		e.Profile = other.Profile
+	}
+	if other.Role != "" || len(other.Roles) > 0 {
+		e.Role = other.Role
+		e.Roles = other.Roles
+	}
+	if other.Table != "" {
+		e.Table = other.Table
+	}
+	if other.Alias != "" {
+		e.Alias = other.Alias
+	}
+	for k, v := range other.EncryptionContext {
+		if e.EncryptionContext == nil {
+			e.EncryptionContext = map[string]string{}
+		}
+		e.EncryptionContext[k] = v
+	}
+	if len(other.Notifications) > 0 {
+		e.Notifications = other.Notifications
+	}
+}
+
+// ContextPolicy encryption context keys which must be supplied when writing secrets
+// whose names start with the prefix
+type ContextPolicy struct {
+	Prefix       string   `yaml:"prefix"`
+	RequiredKeys []string `yaml:"required_keys"`
+}
+
+// ContextPolicyError returned when a write is missing encryption context keys required by policy
+type ContextPolicyError struct {
+	Name    string
+	Missing []string
+}
+
+func (e *ContextPolicyError) Error() string {
+	return fmt.Sprintf("secret %s requires encryption context keys: %s", e.Name, strings.Join(e.Missing, ", "))
+}
+
+// Config the merged contents of the unicreds config files
+type Config struct {
+	Environments     map[string]*Environment `yaml:"environments"`
+	ContextPolicies  []*ContextPolicy        `yaml:"context_policies"`
+	PasswordPolicies []*PasswordPolicy       `yaml:"password_policies"`
+	Notifications    []*NotificationHook     `yaml:"notifications"`
+
+	paths []string
+}
+
+// homeConfigPath returns the config file in the home directory, or an empty string if the
+// home directory isn't known
+func homeConfigPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ConfigFileName)
+}
+
+// DefaultConfigPaths returns the config file in the home directory followed by the nearest
+// config file in the current directory or its parents
+func DefaultConfigPaths() []string {
+	var paths []string
+
+	if home := homeConfigPath(); home != "" {
+		paths = append(paths, home)
+	}
+
+	dir, err := os.Getwd()
+	if err != nil {
+		return paths
+	}
+
+	for {
+		path := filepath.Join(dir, ConfigFileName)
+		if _, err := os.Stat(path); err == nil {
+			if len(paths) == 0 || paths[0] != path {
+				paths = append(paths, path)
+			}
+			break
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+
+	return paths
+}
+
+// LoadConfig read and merge the supplied config files, settings in later files take precedence
+// over earlier ones and files which don't exist are skipped. Top level notifications are only
+// read from the config file in the home directory, as a project config file could be from an
+// untrusted checkout, other files can only add them to an environment which must be selected.
+func LoadConfig(paths ...string) (*Config, error) {
+	cfg := &Config{Environments: map[string]*Environment{}}
+	home := homeConfigPath()
+
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		log.WithField("path", path).Debug("Loading config")
+
+		file := new(Config)
+		if err := yaml.UnmarshalStrict(data, file); err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+
+		for name, env := range file.Environments {
+			if env == nil {
+				continue
+			}
+			for _, hook := range env.Notifications {
+				if err := hook.validate(); err != nil {
+					return nil, fmt.Errorf("%s: environment %s: %v", path, name, err)
+				}
+			}
+			if _, ok := cfg.Environments[name]; !ok {
+				cfg.Environments[name] = new(Environment)
+			}
+			cfg.Environments[name].merge(env)
+		}
+
+		cfg.ContextPolicies = append(cfg.ContextPolicies, file.ContextPolicies...)
+
+		for _, policy := range file.PasswordPolicies {
+			if err := policy.validate(); err != nil {
+				return nil, fmt.Errorf("%s: %v", path, err)
+			}
+			if policy.DenyListFile != "" && !filepath.IsAbs(policy.DenyListFile) {
+				policy.DenyListFile = filepath.Join(filepath.Dir(path), policy.DenyListFile)
+			}
+			cfg.PasswordPolicies = append(cfg.PasswordPolicies, policy)
+		}
+
+		if len(file.Notifications) > 0 && path != home {
+			log.WithField("path", path).Warn("Ignoring notifications outside the home config file, add them to an environment instead")
+			file.Notifications = nil
+		}
+
+		for _, hook := range file.Notifications {
+			if err := hook.validate(); err != nil {
+				return nil, fmt.Errorf("%s: %v", path, err)
+			}
+			cfg.Notifications = append(cfg.Notifications, hook)
+		}
+
+		cfg.paths = append(cfg.paths, path)
+	}
+
+	return cfg, nil
+}
+
+// NotificationHooks returns the hooks from the home config file followed by those of the
+// selected environment, if any
+func (c *Config) NotificationHooks(envName string) ([]*NotificationHook, error) {
+	hooks := append([]*NotificationHook{}, c.Notifications...)
+
+	if envName == "" {
+		return hooks, nil
+	}
+
+	env, err := c.Environment(envName)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(hooks, env.Notifications...), nil
+}
+
+// Environment look up a named environment
+func (c *Config) Environment(name string) (*Environment, error) {
+	env, ok := c.Environments[name]
+	if !ok {
+		return nil, fmt.Errorf("environment %q not found in config files %v", name, c.paths)
+	}
+	return env, nil
+}
+
+// CheckEncryptionContext verify the encryption context has all the keys required by any
+// policy whose prefix matches the name
+func (c *Config) CheckEncryptionContext(name string, encContext *EncryptionContextValue) error {
+	var missing []string
+
+	seen := map[string]bool{}
+
+	for _, policy := range c.ContextPolicies {
+		if !strings.HasPrefix(name, policy.Prefix) {
+			continue
+		}
+
+		for _, key := range policy.RequiredKeys {
+			if _, ok := (*encContext)[key]; !ok && !seen[key] {
+				seen[key] = true
+				missing = append(missing, key)
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		return &ContextPolicyError{Name: name, Missing: missing}
+	}
+
+	return nil
+}
+
+// CheckPasswordPolicy verify the secret meets every password policy whose prefix matches the name
+func (c *Config) CheckPasswordPolicy(name, secret string) error {
+	var violations []string
+
+	for _, policy := range c.PasswordPolicies {
+		if !strings.HasPrefix(name, policy.Prefix) {
+			continue
+		}
+
+		v, err := policy.Check(secret)
+		if err != nil {
+			return err
+		}
+		violations = append(violations, v...)
+	}
+
+	if len(violations) > 0 {
+		return &PasswordPolicyError{Name: name, Violations: violations}
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package unicreds
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeConfig(t *testing.T, dir, name, contents string) string {
+	path := filepath.Join(dir, name)
+	err := ioutil.WriteFile(path, []byte(contents), 0600)
+	assert.Nil(t, err)
+	return path
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "unicreds")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	home := writeConfig(t, dir, "home.yaml", `
+environments:
+  prod:
+    region: us-west-2
+    profile: prod
+    role: arn:aws:iam::123456789012:role/secrets
+    table: credential-store
+    alias: alias/prod
+    encryption_context:
+      stack: prod
+      team: platform
+  dev:
+    region: us-east-1
+`)
+
+	project := writeConfig(t, dir, "project.yaml", `
+environments:
+  prod:
+    table: app-credentials
+    encryption_context:
+      app: billing
+`)
+
+	cfg, err := LoadConfig(home, project, filepath.Join(dir, "missing.yaml"))
+	assert.Nil(t, err)
+
+	prod, err := cfg.Environment("prod")
+	assert.Nil(t, err)
+	assert.Equal(t, "us-west-2", prod.Region)
+	assert.Equal(t, "prod", prod.Profile)
+	assert.Equal(t, "app-credentials", prod.Table)
+	assert.Equal(t, "alias/prod", prod.Alias)
+	assert.Equal(t, []string{"arn:aws:iam::123456789012:role/secrets"}, prod.AllRoles())
+	assert.Equal(t, map[string]string{"stack": "prod", "team": "platform", "app": "billing"}, prod.EncryptionContext)
+
+	dev, err := cfg.Environment("dev")
+	assert.Nil(t, err)
+	assert.Equal(t, "us-east-1", dev.Region)
+
+	_, err = cfg.Environment("staging")
+	assert.Error(t, err)
+}
+
+func TestLoadConfigInvalid(t *testing.T) {
+	dir, err := ioutil.TempDir("", "unicreds")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := writeConfig(t, dir, "bad.yaml", `
+environments:
+  prod:
+    regoin: us-west-2
+`)
+
+	_, err = LoadConfig(path)
+	assert.Error(t, err)
+}
+
+func TestCheckEncryptionContext(t *testing.T) {
+	dir, err := ioutil.TempDir("", "unicreds")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := writeConfig(t, dir, "policy.yaml", `
+context_policies:
+  - prefix: prod/
+    required_keys: [stack, team]
+  - prefix: prod/db/
+    required_keys: [stack, db]
+`)
+
+	cfg, err := LoadConfig(path)
+	assert.Nil(t, err)
+
+	encContext := NewEncryptionContextValue()
+	encContext.Set("stack:prod")
+
+	err = cfg.CheckEncryptionContext("prod/db/password", encContext)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"team", "db"}, err.(*ContextPolicyError).Missing)
+
+	assert.Nil(t, cfg.CheckEncryptionContext("dev/db/password", encContext))
+
+	encContext.Set("team:platform")
+	assert.Nil(t, cfg.CheckEncryptionContext("prod/api/key", encContext))
+}
+
+func TestCheckPasswordPolicy(t *testing.T) {
+	dir, err := ioutil.TempDir("", "unicreds")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	writeConfig(t, dir, "deny.txt", "hunter2hunter2\n")
+
+	path := writeConfig(t, dir, "config.yaml", `
+password_policies:
+  - prefix: prod/
+    min_length: 12
+    required_classes: [digit]
+    deny_list_file: deny.txt
+`)
+
+	cfg, err := LoadConfig(path)
+	assert.Nil(t, err)
+
+	assert.Nil(t, cfg.CheckPasswordPolicy("dev/db", "short"))
+	assert.Nil(t, cfg.CheckPasswordPolicy("prod/db", "long enough 1"))
+
+	err = cfg.CheckPasswordPolicy("prod/db", "short")
+	assert.IsType(t, &PasswordPolicyError{}, err)
+	assert.Len(t, err.(*PasswordPolicyError).Violations, 2)
+
+	err = cfg.CheckPasswordPolicy("prod/db", "HUNTER2hunter2")
+	assert.Equal(t, []string{"is on the deny list"}, err.(*PasswordPolicyError).Violations)
+
+	_, err = LoadConfig(writeConfig(t, dir, "invalid.yaml", `
+password_policies:
+  - prefix: prod/
+    required_classes: [emoji]
+`))
+	assert.Error(t, err)
+}
+
+func TestLoadConfigNotifications(t *testing.T) {
+	dir, err := ioutil.TempDir("", "unicreds")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	home := os.Getenv("HOME")
+	os.Setenv("HOME", dir)
+	defer os.Setenv("HOME", home)
+
+	cfg, err := LoadConfig(writeConfig(t, dir, ConfigFileName, `
+notifications:
+  - prefix: prod/
+    webhook: https://hooks.example.com/secrets
+    secret_env: WEBHOOK_KEY
+    on_failure: fail
+  - sns_topic: arn:aws:sns:us-west-2:123456789012:secrets
+`))
+	assert.Nil(t, err)
+	assert.Len(t, cfg.Notifications, 2)
+
+	for _, invalid := range []string{
+		"notifications:\n  - prefix: prod/\n",
+		"notifications:\n  - webhook: ftp://example.com\n    secret_env: WEBHOOK_KEY\n",
+		"notifications:\n  - webhook: https://example.com\n",
+		"notifications:\n  - sns_topic: arn:aws:sns:us-west-2:123456789012:secrets\n    format: slack\n",
+		"notifications:\n  - webhook: https://example.com\n    secret_env: WEBHOOK_KEY\n    on_failure: ignore\n",
+	} {
+		_, err = LoadConfig(writeConfig(t, dir, ConfigFileName, invalid))
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestLoadConfigProjectNotifications(t *testing.T) {
+	dir, err := ioutil.TempDir("", "unicreds")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	home := os.Getenv("HOME")
+	os.Setenv("HOME", filepath.Join(dir, "home"))
+	defer os.Setenv("HOME", home)
+
+	// a project config file can't add notifications unless its environment is selected
+	cfg, err := LoadConfig(writeConfig(t, dir, ConfigFileName, `
+notifications:
+  - sns_topic: arn:aws:sns:us-west-2:123456789012:untrusted
+environments:
+  prod:
+    notifications:
+      - sns_topic: arn:aws:sns:us-west-2:123456789012:prod
+`))
+	assert.Nil(t, err)
+	assert.Empty(t, cfg.Notifications)
+
+	hooks, err := cfg.NotificationHooks("")
+	assert.Nil(t, err)
+	assert.Empty(t, hooks)
+
+	hooks, err = cfg.NotificationHooks("prod")
+	assert.Nil(t, err)
+	assert.Len(t, hooks, 1)
+	assert.Equal(t, "arn:aws:sns:us-west-2:123456789012:prod", hooks[0].SNSTopic)
+
+	_, err = LoadConfig(writeConfig(t, dir, ConfigFileName, "environments:\n  prod:\n    notifications:\n      - webhook: https://example.com\n"))
+	assert.Error(t, err)
+}
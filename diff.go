@@ -0,0 +1,127 @@
+package unicreds
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// maskedLine replaces the contents of each line of a diff unless values are shown
+const maskedLine = "********"
+
+// SecretsDiff names of the secrets which differ between two stores
+type SecretsDiff struct {
+	// Added secrets only in the target store
+	Added []string
+	// Removed secrets only in the source store
+	Removed []string
+	// Changed secrets in both stores with different values
+	Changed []string
+	// Unchanged secrets in both stores with the same value
+	Unchanged []string
+	// Unreadable secrets listed in either store which couldn't be decrypted there, such as
+	// those with a different encryption context or a key the caller can't use
+	Unreadable []string
+}
+
+// Empty returns true if the stores hold the same secrets
+func (d *SecretsDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0 && len(d.Unreadable) == 0
+}
+
+// DiffSecrets compare the secrets whose names start with the prefix, the listed credentials
+// for each store are used to tell secrets which are missing from those GetAllSecrets
+// skipped because they couldn't be decrypted
+func DiffSecrets(from, to []*DecryptedCredential, fromListed, toListed []*Credential, prefix string) *SecretsDiff {
+	diff := new(SecretsDiff)
+
+	fromSecrets := secretsByName(from, prefix)
+	toSecrets := secretsByName(to, prefix)
+
+	unreadable := map[string]bool{}
+	for _, name := range unreadableNames(fromListed, fromSecrets, prefix) {
+		unreadable[name] = true
+	}
+	for _, name := range unreadableNames(toListed, toSecrets, prefix) {
+		unreadable[name] = true
+	}
+
+	for name := range unreadable {
+		diff.Unreadable = append(diff.Unreadable, name)
+	}
+
+	for name, secret := range fromSecrets {
+		other, ok := toSecrets[name]
+		switch {
+		case unreadable[name]:
+		case !ok:
+			diff.Removed = append(diff.Removed, name)
+		case other != secret:
+			diff.Changed = append(diff.Changed, name)
+		default:
+			diff.Unchanged = append(diff.Unchanged, name)
+		}
+	}
+
+	for name := range toSecrets {
+		if _, ok := fromSecrets[name]; !ok && !unreadable[name] {
+			diff.Added = append(diff.Added, name)
+		}
+	}
+
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Strings(diff.Changed)
+	sort.Strings(diff.Unchanged)
+	sort.Strings(diff.Unreadable)
+
+	return diff
+}
+
+// unreadableNames returns the names with the prefix which are listed but weren't decrypted
+func unreadableNames(listed []*Credential, secrets map[string]string, prefix string) []string {
+	var names []string
+	for _, cred := range listed {
+		if _, ok := secrets[cred.Name]; !ok && strings.HasPrefix(cred.Name, prefix) {
+			names = append(names, cred.Name)
+		}
+	}
+	return names
+}
+
+func secretsByName(creds []*DecryptedCredential, prefix string) map[string]string {
+	secrets := map[string]string{}
+	for _, cred := range creds {
+		if strings.HasPrefix(cred.Name, prefix) {
+			secrets[cred.Name] = cred.Secret
+		}
+	}
+	return secrets
+}
+
+// DiffValues returns a unified diff of two secret values, the content of each line is masked
+// unless show is set so only the shape of the change is revealed
+func DiffValues(from, to, fromLabel, toLabel string, show bool) (string, error) {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from),
+		B:        difflib.SplitLines(to),
+		FromFile: fromLabel,
+		ToFile:   toLabel,
+		Context:  3,
+	})
+	if err != nil || show {
+		return diff, err
+	}
+
+	// the first two lines are the file headers, a removed line could also start with ---
+	lines := strings.SplitAfter(diff, "\n")
+	for i, line := range lines {
+		if i < 2 || line == "" || strings.HasPrefix(line, "@@") {
+			continue
+		}
+		lines[i] = line[:1] + maskedLine + "\n"
+	}
+
+	return strings.Join(lines, ""), nil
+}
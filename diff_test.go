@@ -0,0 +1,87 @@
+package unicreds
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffSecrets(t *testing.T) {
+	from := []*DecryptedCredential{
+		{Credential: &Credential{Name: "app/db"}, Secret: "one"},
+		{Credential: &Credential{Name: "app/api"}, Secret: "two"},
+		{Credential: &Credential{Name: "app/old"}, Secret: "three"},
+		{Credential: &Credential{Name: "other/db"}, Secret: "four"},
+	}
+	to := []*DecryptedCredential{
+		{Credential: &Credential{Name: "app/db"}, Secret: "one"},
+		{Credential: &Credential{Name: "app/api"}, Secret: "changed"},
+		{Credential: &Credential{Name: "app/new"}, Secret: "five"},
+	}
+
+	diff := DiffSecrets(from, to, listed(from), listed(to), "app/")
+
+	assert.Equal(t, []string{"app/new"}, diff.Added)
+	assert.Equal(t, []string{"app/old"}, diff.Removed)
+	assert.Equal(t, []string{"app/api"}, diff.Changed)
+	assert.Equal(t, []string{"app/db"}, diff.Unchanged)
+	assert.Nil(t, diff.Unreadable)
+	assert.False(t, diff.Empty())
+
+	assert.True(t, DiffSecrets(from[:1], to[:1], listed(from[:1]), listed(to[:1]), "").Empty())
+}
+
+func TestDiffSecretsUnreadable(t *testing.T) {
+	from := []*DecryptedCredential{
+		{Credential: &Credential{Name: "app/db"}, Secret: "one"},
+		{Credential: &Credential{Name: "app/api"}, Secret: "two"},
+	}
+	to := []*DecryptedCredential{
+		{Credential: &Credential{Name: "app/db"}, Secret: "one"},
+		{Credential: &Credential{Name: "app/new"}, Secret: "three"},
+	}
+
+	// app/api is stored in the target with a context which can't be decrypted, app/new in the
+	// source, neither should be reported as added or removed
+	toListed := append(listed(to), &Credential{Name: "app/api"})
+	fromListed := append(listed(from), &Credential{Name: "app/new"})
+
+	diff := DiffSecrets(from, to, fromListed, toListed, "app/")
+
+	assert.Nil(t, diff.Added)
+	assert.Nil(t, diff.Removed)
+	assert.Equal(t, []string{"app/api", "app/new"}, diff.Unreadable)
+	assert.Equal(t, []string{"app/db"}, diff.Unchanged)
+	assert.False(t, diff.Empty())
+}
+
+func listed(creds []*DecryptedCredential) []*Credential {
+	var listed []*Credential
+	for _, cred := range creds {
+		listed = append(listed, cred.Credential)
+	}
+	return listed
+}
+
+func TestDiffValues(t *testing.T) {
+	from := "-----BEGIN KEY-----\nabc\n-----END KEY-----\n"
+	to := "-----BEGIN KEY-----\nxyz\n-----END KEY-----\n"
+
+	diff, err := DiffValues(from, to, "test@1", "test@2", true)
+	assert.Nil(t, err)
+	assert.Contains(t, diff, "-abc\n")
+	assert.Contains(t, diff, "+xyz\n")
+
+	diff, err = DiffValues(from, to, "test@1", "test@2", false)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(diff, "--- test@1\n+++ test@2\n@@ "))
+	assert.Contains(t, diff, "-"+maskedLine+"\n")
+	assert.NotContains(t, diff, "abc")
+	assert.NotContains(t, diff, "xyz")
+	assert.NotContains(t, diff, "KEY")
+
+	diff, err = DiffValues(from, from, "test@1", "test@2", false)
+	assert.Nil(t, err)
+	assert.Equal(t, "", diff)
+}
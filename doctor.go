@@ -0,0 +1,208 @@
+package unicreds
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/kms"
+)
+
+// DoctorCheck the outcome of a single health check
+type DoctorCheck struct {
+	Name   string
+	Passed bool
+	Detail string
+	Hint   string
+
+	// Err the error which failed the check, if it failed on a request
+	Err error
+}
+
+// DoctorError returned when checks failed, it wraps the error behind the first failed check
+// so it maps to the same exit code as the failure would elsewhere
+type DoctorError struct {
+	Failed []*DoctorCheck
+}
+
+func (e *DoctorError) Error() string {
+	names := make([]string, 0, len(e.Failed))
+	for _, check := range e.Failed {
+		names = append(names, check.Name)
+	}
+	return fmt.Sprintf("doctor found problems: %s", strings.Join(names, ", "))
+}
+
+// Unwrap returns the error behind the first failed check which has one
+func (e *DoctorError) Unwrap() error {
+	for _, check := range e.Failed {
+		if check.Err != nil {
+			return check.Err
+		}
+	}
+	return nil
+}
+
+// Doctor run a series of checks against the region, credentials, table and kms key which
+// unicreds depends on, returning a report with remediation hints for any failures
+func Doctor(tableName *string, alias string, encContext *EncryptionContextValue) []*DoctorCheck {
+	log.Debug("Running doctor")
+
+	if alias == "" {
+		alias = DefaultKmsKey
+	}
+
+	return []*DoctorCheck{
+		checkRegion(),
+		checkCallerIdentity(),
+		checkTable(tableName),
+		checkKmsKey(alias),
+		checkRoundTrip(alias, encContext),
+	}
+}
+
+func checkRegion() *DoctorCheck {
+	check := &DoctorCheck{Name: "Region"}
+
+	if awsRegion == "" {
+		check.Detail = "no region found in flags, environment, profile, instance or task metadata"
+		check.Hint = "Pass --region, set AWS_REGION or add a region to your profile in ~/.aws/config"
+		return check
+	}
+
+	check.Passed = true
+	check.Detail = awsRegion
+	if awsRegionSource != "" {
+		check.Detail = fmt.Sprintf("%s (from %s)", awsRegion, awsRegionSource)
+	}
+	return check
+}
+
+func checkCallerIdentity() *DoctorCheck {
+	check := &DoctorCheck{Name: "Caller identity"}
+
+	arn, err := GetCallerIdentity()
+	if err != nil {
+		check.Err = err
+		check.Detail = err.Error()
+		check.Hint = "Check your AWS credentials, --profile and --role settings"
+		return check
+	}
+
+	check.Passed = true
+	check.Detail = arn
+	return check
+}
+
+func checkTable(tableName *string) *DoctorCheck {
+	check := &DoctorCheck{Name: "DynamoDB table"}
+
+	res, err := dynamoSvc.DescribeTable(&dynamodb.DescribeTableInput{
+		TableName: tableName,
+	})
+	if err != nil {
+		check.Err = err
+		check.Detail = err.Error()
+		check.Hint = remediationHint(err, "dynamodb:DescribeTable",
+			fmt.Sprintf("Run unicreds setup to create %s, or pass the correct --table and --region", aws.StringValue(tableName)))
+		return check
+	}
+
+	keys := map[string]string{}
+	for _, k := range res.Table.KeySchema {
+		keys[aws.StringValue(k.AttributeName)] = aws.StringValue(k.KeyType)
+	}
+
+	if keys["name"] != dynamodb.KeyTypeHash || keys["version"] != dynamodb.KeyTypeRange || len(keys) != 2 {
+		check.Detail = "unexpected key schema"
+		check.Hint = "The table must have a name hash key and a version range key, was it created by unicreds or credstash?"
+		return check
+	}
+
+	status := aws.StringValue(res.Table.TableStatus)
+	if status != dynamodb.TableStatusActive {
+		check.Detail = fmt.Sprintf("table status is %s", status)
+		check.Hint = "Wait for the table to become ACTIVE"
+		return check
+	}
+
+	check.Passed = true
+	check.Detail = fmt.Sprintf("%s is %s", aws.StringValue(tableName), status)
+	return check
+}
+
+func checkKmsKey(alias string) *DoctorCheck {
+	check := &DoctorCheck{Name: "KMS key"}
+
+	res, err := kmsSvc.DescribeKey(&kms.DescribeKeyInput{
+		KeyId: aws.String(alias),
+	})
+	if err != nil {
+		check.Err = err
+		check.Detail = err.Error()
+		check.Hint = remediationHint(err, "kms:DescribeKey",
+			fmt.Sprintf("Create a KMS key and assign it the %s alias, or pass the correct --alias", alias))
+		return check
+	}
+
+	state := aws.StringValue(res.KeyMetadata.KeyState)
+	if state != kms.KeyStateEnabled {
+		check.Detail = fmt.Sprintf("key state is %s", state)
+		check.Hint = "Enable the key, or cancel its pending deletion"
+		return check
+	}
+
+	check.Passed = true
+	check.Detail = aws.StringValue(res.KeyMetadata.Arn)
+	return check
+}
+
+func checkRoundTrip(alias string, encContext *EncryptionContextValue) *DoctorCheck {
+	check := &DoctorCheck{Name: "KMS round trip"}
+
+	dk, err := GenerateDataKey(alias, encContext, 64)
+	if err != nil {
+		check.Err = err
+		check.Detail = err.Error()
+		check.Hint = remediationHint(err, "kms:GenerateDataKey", "Check the key policy allows you to generate data keys")
+		return check
+	}
+
+	// call kms directly so the data key cache can't mask a decrypt failure
+	res, err := kmsSvc.Decrypt(&kms.DecryptInput{
+		CiphertextBlob:    dk.CiphertextBlob,
+		EncryptionContext: *encContext,
+	})
+	if err != nil {
+		check.Err = err
+		check.Detail = err.Error()
+		check.Hint = remediationHint(err, "kms:Decrypt", "Check the key policy allows you to decrypt with this encryption context")
+		return check
+	}
+
+	if !bytes.Equal(res.Plaintext, dk.Plaintext) {
+		check.Detail = "decrypted data key did not match the generated data key"
+		return check
+	}
+
+	check.Passed = true
+	check.Detail = "generated and decrypted a data key"
+	return check
+}
+
+// remediationHint suggest a fix based on the aws error code
+func remediationHint(err error, action, fallback string) string {
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch awsErr.Code() {
+		case "AccessDeniedException", "AccessDenied":
+			return fmt.Sprintf("Grant %s to your IAM identity, and check any key policy or encryption context conditions", action)
+		case "UnrecognizedClientException", "InvalidClientTokenId", "ExpiredToken", "ExpiredTokenException":
+			return "Your AWS credentials are invalid or expired, refresh them and try again"
+		}
+	}
+	return fallback
+}
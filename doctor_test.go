@@ -0,0 +1,86 @@
+package unicreds
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/versent/unicreds/mocks"
+)
+
+func configureDoctorMock() (*mocks.DynamoDBAPI, *mocks.KMSAPI) {
+	dsMock, kmsMock := configureMock()
+
+	stsMock := &mocks.STSAPI{}
+	stsSvc = stsMock
+
+	gci := &sts.GetCallerIdentityOutput{Arn: aws.String("arn:aws:iam::123456789012:user/test")}
+	stsMock.On("GetCallerIdentity", mock.AnythingOfType("*sts.GetCallerIdentityInput")).Return(gci, nil)
+
+	dko := &kms.DescribeKeyOutput{
+		KeyMetadata: &kms.KeyMetadata{
+			Arn:      aws.String("arn:aws:kms:us-west-2:123456789012:key/abc"),
+			KeyState: aws.String(kms.KeyStateEnabled),
+		},
+	}
+	gdk := &kms.GenerateDataKeyOutput{CiphertextBlob: []byte("wrapped"), Plaintext: dsPlainText}
+	ki := &kms.DecryptOutput{Plaintext: dsPlainText}
+
+	kmsMock.On("DescribeKey", mock.AnythingOfType("*kms.DescribeKeyInput")).Return(dko, nil)
+	kmsMock.On("GenerateDataKey", mock.AnythingOfType("*kms.GenerateDataKeyInput")).Return(gdk, nil)
+	kmsMock.On("Decrypt", mock.AnythingOfType("*kms.DecryptInput")).Return(ki, nil)
+
+	awsRegion = "us-west-2"
+
+	return dsMock, kmsMock
+}
+
+func TestDoctor(t *testing.T) {
+
+	dsMock, _ := configureDoctorMock()
+	defer func() { awsRegion = "" }()
+
+	dto := &dynamodb.DescribeTableOutput{
+		Table: &dynamodb.TableDescription{
+			TableStatus: aws.String(dynamodb.TableStatusActive),
+			KeySchema: []*dynamodb.KeySchemaElement{
+				{AttributeName: aws.String("name"), KeyType: aws.String(dynamodb.KeyTypeHash)},
+				{AttributeName: aws.String("version"), KeyType: aws.String(dynamodb.KeyTypeRange)},
+			},
+		},
+	}
+
+	dsMock.On("DescribeTable", mock.AnythingOfType("*dynamodb.DescribeTableInput")).Return(dto, nil)
+
+	checks := Doctor(&tableName, "", NewEncryptionContextValue())
+
+	assert.Len(t, checks, 5)
+	for _, check := range checks {
+		assert.True(t, check.Passed, check.Name)
+	}
+}
+
+func TestDoctorTableAccessDenied(t *testing.T) {
+
+	dsMock, _ := configureDoctorMock()
+	defer func() { awsRegion = "" }()
+
+	awsErr := awserr.New("AccessDeniedException", "not authorized", nil)
+
+	dsMock.On("DescribeTable", mock.AnythingOfType("*dynamodb.DescribeTableInput")).Return(nil, awsErr)
+
+	checks := Doctor(&tableName, "", NewEncryptionContextValue())
+
+	assert.False(t, checks[2].Passed)
+	assert.Contains(t, checks[2].Hint, "dynamodb:DescribeTable")
+	assert.True(t, checks[3].Passed)
+
+	err := &DoctorError{Failed: []*DoctorCheck{checks[2]}}
+	assert.Equal(t, "doctor found problems: DynamoDB table", err.Error())
+	assert.Equal(t, ErrorCodeAccessDenied, ErrorCode(err))
+}
@@ -4,11 +4,11 @@ import (
 	"bytes"
 	"encoding/base64"
 	"errors"
-	"io/ioutil"
-	"net/http"
+	"math/rand"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/apex/log"
@@ -28,12 +28,23 @@ const (
 	// from the secret/Name
 	CreatedAtNotAvailable = "Not Available"
 
+	// DefaultDecryptConcurrency default number of secrets decrypted in parallel
+	DefaultDecryptConcurrency = 8
+
 	tableCreateTimeout = 30 * time.Second
+
+	batchGetMaxKeys        = 100 // BatchGetItem limit per request
+	batchGetMaxRetries     = 5
+	batchGetRetryBaseDelay = 50 * time.Millisecond
+
+	scanVersionsThreshold = 20 // names past which GetSecrets scans for versions rather than querying each
 )
 
 var (
 	dynamoSvc dynamodbiface.DynamoDBAPI
 
+	decryptConcurrency = DefaultDecryptConcurrency
+
 	// ErrSecretNotFound returned when unable to find the specified secret in dynamodb
 	ErrSecretNotFound = errors.New("Secret Not Found")
 
@@ -42,6 +53,9 @@ var (
 
 	// ErrTimeout timeout occured waiting for dynamodb table to create
 	ErrTimeout = errors.New("Timed out waiting for dynamodb table to become active")
+
+	// ErrUnprocessedKeys returned when dynamodb repeatedly failed to process a batch get
+	ErrUnprocessedKeys = errors.New("Timed out retrying unprocessed keys from dynamodb")
 )
 
 func init() {
@@ -57,6 +71,11 @@ func SetDynamoDBSession(sess *session.Session) {
 	dynamoSvc = dynamodb.New(sess)
 }
 
+// SetDecryptConcurrency set the number of secrets decrypted in parallel by GetAllSecrets
+func SetDecryptConcurrency(n int) {
+	decryptConcurrency = n
+}
+
 // Credential managed credential information
 type Credential struct {
 	Name      string `dynamodbav:"name"`
@@ -65,6 +84,46 @@ type Credential struct {
 	Contents  string `dynamodbav:"contents"`
 	Hmac      []byte `dynamodbav:"hmac"`
 	CreatedAt int64  `dynamodbav:"created_at"`
+
+	// Binary the secret contents are raw bytes rather than text
+	Binary bool `dynamodbav:"binary,omitempty"`
+
+	// Compression applied to the secret before it was encrypted
+	Compression string `dynamodbav:"compression,omitempty"`
+
+	// Chunks number of items the encrypted contents are split across
+	Chunks int `dynamodbav:"chunks,omitempty"`
+	// ChunkID identifies the items holding the remaining chunks
+	ChunkID string `dynamodbav:"chunk_id,omitempty"`
+	// ChunkOf set on chunk items to the name of the secret they belong to
+	ChunkOf string `dynamodbav:"chunk_of,omitempty"`
+
+	// KeyArn ARN of the KMS key which encrypted the data key
+	KeyArn string `dynamodbav:"key_arn,omitempty"`
+
+	// RolledBackFrom version this secret was copied from by a rollback
+	RolledBackFrom string `dynamodbav:"rolled_back_from,omitempty"`
+
+	// ContextKeys keys of the encryption context recorded when the secret was stored
+	ContextKeys []string `dynamodbav:"context_keys,stringset,omitempty"`
+	// Context encryption context recorded when the secret was stored
+	Context map[string]string `dynamodbav:"context,omitempty"`
+}
+
+// PutOptions optional settings used when storing a secret
+type PutOptions struct {
+	// RecordContext store the encryption context keys on the item
+	RecordContext bool
+
+	// RecordContextValues store the encryption context keys and values on the item so
+	// reads without a context can use them
+	RecordContextValues bool
+
+	// Binary flag the secret as raw bytes so readers don't treat it as text
+	Binary bool
+
+	// RolledBackFrom record the version a rollback copied the secret from
+	RolledBackFrom string
 }
 
 // CreatedAtDate convert the timestamp field to a date string
@@ -120,43 +179,7 @@ func PaddedInt(i int) string {
 
 // Setup create the table which stores credentials
 func Setup(tableName *string, read *int64, write *int64) (err error) {
-	log.Debug("Running Setup")
-
-	_, err = dynamoSvc.CreateTable(&dynamodb.CreateTableInput{
-		AttributeDefinitions: []*dynamodb.AttributeDefinition{
-			{
-				AttributeName: aws.String("name"),
-				AttributeType: aws.String("S"),
-			},
-			{
-				AttributeName: aws.String("version"),
-				AttributeType: aws.String("S"),
-			},
-		},
-		KeySchema: []*dynamodb.KeySchemaElement{
-			{
-				AttributeName: aws.String("name"),
-				KeyType:       aws.String(dynamodb.KeyTypeHash),
-			},
-			{
-				AttributeName: aws.String("version"),
-				KeyType:       aws.String(dynamodb.KeyTypeRange),
-			},
-		},
-		ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
-			ReadCapacityUnits:  read,
-			WriteCapacityUnits: write,
-		},
-		TableName: tableName,
-	})
-
-	if err != nil {
-		return
-	}
-
-	err = waitForTable(tableName)
-
-	return
+	return SetupWithOptions(tableName, read, write, nil)
 }
 
 // GetHighestVersionSecret retrieves latest secret from dynamodb using the name
@@ -174,9 +197,9 @@ func GetHighestVersionSecret(tableName *string, name string, encContext *Encrypt
 			},
 		},
 		KeyConditionExpression: aws.String("#N = :name"),
-		Limit:            aws.Int64(1),
-		ConsistentRead:   aws.Bool(true),
-		ScanIndexForward: aws.Bool(false), // descending order
+		Limit:                  aws.Int64(1),
+		ConsistentRead:         aws.Bool(true),
+		ScanIndexForward:       aws.Bool(false), // descending order
 	})
 
 	if err != nil {
@@ -195,6 +218,10 @@ func GetHighestVersionSecret(tableName *string, name string, encContext *Encrypt
 		return nil, err
 	}
 
+	if err = assembleChunks(tableName, cred); err != nil {
+		return nil, err
+	}
+
 	return decryptCredential(cred, encContext)
 }
 
@@ -223,6 +250,10 @@ func GetSecret(tableName *string, name, version string, encContext *EncryptionCo
 		return nil, err
 	}
 
+	if err = assembleChunks(tableName, cred); err != nil {
+		return nil, err
+	}
+
 	return decryptCredential(cred, encContext)
 }
 
@@ -241,10 +272,10 @@ func GetHighestVersion(tableName *string, name string) (string, error) {
 			},
 		},
 		KeyConditionExpression: aws.String("#N = :name"),
-		Limit:                aws.Int64(1),
-		ConsistentRead:       aws.Bool(true),
-		ScanIndexForward:     aws.Bool(false), // descending order
-		ProjectionExpression: aws.String("version"),
+		Limit:                  aws.Int64(1),
+		ConsistentRead:         aws.Bool(true),
+		ScanIndexForward:       aws.Bool(false), // descending order
+		ProjectionExpression:   aws.String("version"),
 	})
 
 	if err != nil {
@@ -276,8 +307,9 @@ func ListSecrets(tableName *string, allVersions bool) ([]*Credential, error) {
 			TableName: tableName,
 			ExpressionAttributeNames: map[string]*string{
 				"#N": aws.String("name"),
+				"#C": aws.String("context"),
 			},
-			ProjectionExpression: aws.String("#N, version, created_at"),
+			ProjectionExpression: aws.String("#N, version, created_at, context_keys, #C, chunk_of"),
 			ConsistentRead:       aws.Bool(true),
 			ExclusiveStartKey:    lastEvaluatedKey,
 		})
@@ -297,6 +329,8 @@ func ListSecrets(tableName *string, allVersions bool) ([]*Credential, error) {
 		return nil, err
 	}
 
+	creds = withoutChunks(creds)
+
 	if !allVersions {
 		creds, err = filterLatest(creds)
 		if err != nil {
@@ -326,6 +360,13 @@ func GetAllSecrets(tableName *string, allVersions bool, encContext *EncryptionCo
 				aws.String("contents"),
 				aws.String("hmac"),
 				aws.String("created_at"),
+				aws.String("context_keys"),
+				aws.String("context"),
+				aws.String("binary"),
+				aws.String("compression"),
+				aws.String("chunks"),
+				aws.String("chunk_id"),
+				aws.String("chunk_of"),
 			},
 			ConsistentRead:    aws.Bool(true),
 			ExclusiveStartKey: lastEvaluatedKey,
@@ -346,6 +387,8 @@ func GetAllSecrets(tableName *string, allVersions bool, encContext *EncryptionCo
 		return nil, err
 	}
 
+	creds = withoutChunks(creds)
+
 	if !allVersions {
 		creds, err = filterLatest(creds)
 		if err != nil {
@@ -355,30 +398,167 @@ func GetAllSecrets(tableName *string, allVersions bool, encContext *EncryptionCo
 
 	sort.Sort(ByName(creds))
 
+	for _, cred := range creds {
+		if err = assembleChunks(tableName, cred); err != nil {
+			return nil, err
+		}
+	}
+
+	decrypted, errs := decryptCredentials(creds, encContext)
+
 	var results []*DecryptedCredential
 
-	for _, cred := range creds {
+	for i, cred := range creds {
+		if err := errs[i]; err != nil {
+			awsErr, ok := err.(awserr.Error)
+			if !ok {
+				// the row itself is bad, such as a failed hmac or contents which won't decode,
+				// so it shouldn't stop the other secrets being read
+				log.WithField("name", cred.Name).WithError(err).Warn("Skipping unreadable secret")
+				continue
+			}
+			if awsErr.Code() == "AccessDeniedException" || awsErr.Code() == "InvalidCiphertextException" {
+				log.Debugf("%s: %s", err, cred.Name)
+				continue
+			}
+			return nil, err
+		}
 
-		dcred, err := decryptCredential(cred, encContext)
+		results = append(results, decrypted[i])
+	}
+
+	return results, nil
+}
+
+// GetSecrets look up the latest version of each of the named secrets, fetching the items in
+// batches, names which don't exist are returned in the missing list. A few names have their
+// versions looked up with a query each, past scanVersionsThreshold names it's cheaper to
+// take all of them from one scan of the table.
+func GetSecrets(tableName *string, names []string, encContext *EncryptionContextValue) (map[string]*DecryptedCredential, []string, error) {
+	log.WithField("count", len(names)).Debug("Getting secrets")
+
+	var missing []string
+
+	versions := map[string]string{}
+	seen := map[string]bool{}
+
+	if len(names) > scanVersionsThreshold {
+		creds, err := ListSecrets(tableName, false)
 		if err != nil {
-			if awsErr, ok := err.(awserr.Error); ok {
-				if awsErr.Code() == "AccessDeniedException" || awsErr.Code() == "InvalidCiphertextException" {
-					log.Debugf("%s: %s", err, cred.Name)
+			return nil, nil, err
+		}
+
+		latest := make(map[string]string, len(creds))
+		for _, cred := range creds {
+			latest[cred.Name] = cred.Version
+		}
+
+		for _, name := range names {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			version, ok := latest[name]
+			if !ok {
+				missing = append(missing, name)
+				continue
+			}
+			versions[name] = version
+		}
+	} else {
+		for _, name := range names {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			version, err := GetHighestVersion(tableName, name)
+			if err != nil {
+				if err == ErrSecretNotFound {
+					missing = append(missing, name)
 					continue
 				}
+				return nil, nil, err
 			}
+
+			versions[name] = version
 		}
+	}
 
-		results = append(results, dcred)
+	results, gone, err := GetSecretVersions(tableName, versions, encContext)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return results, nil
+	// an item may have been deleted between resolving the version and fetching it
+	return results, append(missing, gone...), nil
+}
+
+// GetSecretVersions fetch the given version of each named secret in batches, without looking
+// up any versions, the names of items which no longer exist are returned in the missing list
+func GetSecretVersions(tableName *string, versions map[string]string, encContext *EncryptionContextValue) (map[string]*DecryptedCredential, []string, error) {
+	log.WithField("count", len(versions)).Debug("Getting secret versions")
+
+	names := make([]string, 0, len(versions))
+	for name := range versions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	keys := make([]map[string]*dynamodb.AttributeValue, 0, len(names))
+	for _, name := range names {
+		keys = append(keys, map[string]*dynamodb.AttributeValue{
+			"name":    {S: aws.String(name)},
+			"version": {S: aws.String(versions[name])},
+		})
+	}
+
+	creds, err := batchGetCredentials(tableName, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, cred := range creds {
+		if err = assembleChunks(tableName, cred); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	decrypted, errs := decryptCredentials(creds, encContext)
+
+	results := make(map[string]*DecryptedCredential, len(creds))
+
+	for i, cred := range creds {
+		if errs[i] != nil {
+			return nil, nil, errs[i]
+		}
+		results[cred.Name] = decrypted[i]
+	}
+
+	var missing []string
+	for _, name := range names {
+		if _, ok := results[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+
+	return results, missing, nil
 }
 
 // PutSecret retrieve the secret from dynamodb
 func PutSecret(tableName *string, alias, name, secret, version string, encContext *EncryptionContextValue) error {
+	return PutSecretWithOptions(tableName, alias, name, secret, version, encContext, nil)
+}
+
+// PutSecretWithOptions store the secret in dynamodb using the supplied options
+func PutSecretWithOptions(tableName *string, alias, name, secret, version string, encContext *EncryptionContextValue, opts *PutOptions) error {
 	log.Debug("Putting secret")
 
+	if opts == nil {
+		opts = &PutOptions{}
+	}
+
 	kmsKey := DefaultKmsKey
 
 	if alias != "" {
@@ -399,7 +579,22 @@ func PutSecret(tableName *string, alias, name, secret, version string, encContex
 	hmacKey := dk.Plaintext[32:]
 	wrappedKey := dk.CiphertextBlob
 
-	ctext, err := Encrypt(dataKey, []byte(secret))
+	plaintext := []byte(secret)
+	compression := ""
+
+	// compress secrets which won't fit on a single item
+	if base64.StdEncoding.EncodedLen(len(plaintext)) > maxChunkSize {
+		compressed, err := compress(plaintext)
+		if err != nil {
+			return err
+		}
+		if len(compressed) < len(plaintext) {
+			plaintext = compressed
+			compression = CompressionGzip
+		}
+	}
+
+	ctext, err := Encrypt(dataKey, plaintext)
 	if err != nil {
 		log.Debugf("Encrypt failed: %v", err)
 		return err
@@ -407,15 +602,48 @@ func PutSecret(tableName *string, alias, name, secret, version string, encContex
 
 	b64hmac := ComputeHmac256(ctext, hmacKey)
 
-	b64ctext := base64.StdEncoding.EncodeToString(ctext)
+	chunks := splitContents(base64.StdEncoding.EncodeToString(ctext))
 
 	cred := &Credential{
-		Name:      name,
-		Version:   version,
-		Key:       base64.StdEncoding.EncodeToString(wrappedKey),
-		Contents:  b64ctext,
-		Hmac:      b64hmac,
-		CreatedAt: time.Now().Unix(),
+		Name:        name,
+		Version:     version,
+		Key:         base64.StdEncoding.EncodeToString(wrappedKey),
+		Contents:    chunks[0],
+		Hmac:        b64hmac,
+		CreatedAt:   time.Now().Unix(),
+		Binary:      opts.Binary,
+		Compression: compression,
+		KeyArn:      dk.KeyID,
+
+		RolledBackFrom: opts.RolledBackFrom,
+	}
+
+	if encContext != nil && (opts.RecordContext || opts.RecordContextValues) {
+		for key, value := range *encContext {
+			cred.ContextKeys = append(cred.ContextKeys, key)
+			if opts.RecordContextValues {
+				if cred.Context == nil {
+					cred.Context = map[string]string{}
+				}
+				cred.Context[key] = aws.StringValue(value)
+			}
+		}
+		sort.Strings(cred.ContextKeys)
+	}
+
+	// the chunks are written first so readers never see a partial secret
+	if len(chunks) > 1 {
+		cred.Chunks = len(chunks)
+		if cred.ChunkID, err = newChunkID(); err != nil {
+			return err
+		}
+
+		log.WithFields(log.Fields{"name": name, "chunks": cred.Chunks}).Debug("Putting chunks")
+
+		if err = putChunks(tableName, cred, chunks[1:]); err != nil {
+			removeChunks(tableName, cred)
+			return err
+		}
 	}
 
 	data, err := Encode(cred)
@@ -434,40 +662,31 @@ func PutSecret(tableName *string, alias, name, secret, version string, encContex
 		ConditionExpression: aws.String("attribute_not_exists(#N)"),
 	})
 
-	return err
+	if err != nil {
+		if cred.ChunkID != "" {
+			removeChunks(tableName, cred)
+		}
+		return err
+	}
+
+	action := NotifyPut
+	if opts.RolledBackFrom != "" {
+		action = NotifyRollback
+	}
+
+	return notify(tableName, action, name, version)
 }
 
 // DeleteSecret delete a secret
 func DeleteSecret(tableName *string, name string) error {
 	log.Debug("Deleting secret")
 
-	res, err := dynamoSvc.Query(&dynamodb.QueryInput{
-		TableName: tableName,
-		ExpressionAttributeNames: map[string]*string{
-			"#N": aws.String("name"),
-		},
-		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
-			":name": {
-				S: aws.String(name),
-			},
-		},
-		KeyConditionExpression: aws.String("#N = :name"),
-		ConsistentRead:         aws.Bool(true),
-		ScanIndexForward:       aws.Bool(false), // descending order
-	})
-
+	creds, err := queryVersions(tableName, name, false)
 	if err != nil {
 		return err
 	}
 
-	for _, item := range res.Items {
-		cred := new(Credential)
-
-		err = Decode(item, cred)
-		if err != nil {
-			return err
-		}
-
+	for _, cred := range creds {
 		log.WithFields(log.Fields{"name": cred.Name, "version": cred.Version}).Info("deleting")
 
 		_, err = dynamoSvc.DeleteItem(&dynamodb.DeleteItemInput{
@@ -485,9 +704,60 @@ func DeleteSecret(tableName *string, name string) error {
 		if err != nil {
 			return err
 		}
+
+		if cred.ChunkID != "" {
+			if err = deleteChunks(tableName, cred.Name, cred.ChunkID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(creds) == 0 {
+		return nil
+	}
+
+	return notify(tableName, NotifyDelete, name, "")
+}
+
+// queryVersions returns every version of the named secret, paging through the results
+func queryVersions(tableName *string, name string, ascending bool) ([]*Credential, error) {
+	var creds []*Credential
+	var lastEvaluatedKey map[string]*dynamodb.AttributeValue
+
+	for {
+		res, err := dynamoSvc.Query(&dynamodb.QueryInput{
+			TableName: tableName,
+			ExpressionAttributeNames: map[string]*string{
+				"#N": aws.String("name"),
+			},
+			ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+				":name": {
+					S: aws.String(name),
+				},
+			},
+			KeyConditionExpression: aws.String("#N = :name"),
+			ConsistentRead:         aws.Bool(true),
+			ScanIndexForward:       aws.Bool(ascending),
+			ExclusiveStartKey:      lastEvaluatedKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		page, err := decodeCredential(res.Items)
+		if err != nil {
+			return nil, err
+		}
+
+		creds = append(creds, page...)
+
+		lastEvaluatedKey = res.LastEvaluatedKey
+		if lastEvaluatedKey == nil {
+			break
+		}
 	}
 
-	return nil
+	return creds, nil
 }
 
 // ResolveVersion converts an integer version to a string, or if a version isn't provided (0),
@@ -518,6 +788,13 @@ func ResolveVersion(tableName *string, name string, version int) (string, error)
 
 func decryptCredential(cred *Credential, encContext *EncryptionContextValue) (*DecryptedCredential, error) {
 
+	// fall back to the context recorded with the secret when none is supplied
+	if (encContext == nil || len(*encContext) == 0) && len(cred.Context) > 0 {
+		log.WithField("name", cred.Name).Debug("Using stored encryption context")
+		encContext = NewEncryptionContextValue()
+		encContext.SetMap(cred.Context)
+	}
+
 	wrappedKey, err := base64.StdEncoding.DecodeString(cred.Key)
 
 	if err != nil {
@@ -531,14 +808,21 @@ func decryptCredential(cred *Credential, encContext *EncryptionContextValue) (*D
 		case "AccessDeniedException":
 			err = awserr.New(awsErr.Code(), "KMS Access Denied to decrypt", nil)
 		case "InvalidCiphertextException":
-			err = awserr.New(awsErr.Code(), "The encryption context provided "+
-				"may not match the one used when the credential was stored", nil)
+			msg := "The encryption context provided may not match the one used when the credential was stored"
+			if len(cred.ContextKeys) > 0 {
+				msg += ", it was stored with the keys: " + strings.Join(cred.ContextKeys, ", ")
+			}
+			err = awserr.New(awsErr.Code(), msg, nil)
 		}
 	}
 	if err != nil {
 		return nil, err
 	}
 
+	if cred.KeyArn == "" {
+		cred.KeyArn = dk.KeyID
+	}
+
 	dataKey := dk.Plaintext[:32]
 	hmacKey := dk.Plaintext[32:]
 
@@ -559,11 +843,119 @@ func decryptCredential(cred *Credential, encContext *EncryptionContextValue) (*D
 		return nil, err
 	}
 
+	secret, err = decompress(cred.Compression, secret)
+	if err != nil {
+		return nil, err
+	}
+
 	plainText := string(secret)
 
 	return &DecryptedCredential{Credential: cred, Secret: plainText}, nil
 }
 
+// decryptCredentials decrypts the supplied credentials using a bounded pool of
+// workers, results and errors are returned in the same order as the input
+func decryptCredentials(creds []*Credential, encContext *EncryptionContextValue) ([]*DecryptedCredential, []error) {
+
+	workers := decryptConcurrency
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(creds) {
+		workers = len(creds)
+	}
+
+	decrypted := make([]*DecryptedCredential, len(creds))
+	errs := make([]error, len(creds))
+
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				decrypted[i], errs[i] = decryptCredentialWithBackoff(creds[i], encContext)
+			}
+		}()
+	}
+
+	for i := range creds {
+		indexes <- i
+	}
+	close(indexes)
+
+	wg.Wait()
+
+	return decrypted, errs
+}
+
+// decryptCredentialWithBackoff retries decryption while KMS is throttling requests
+func decryptCredentialWithBackoff(cred *Credential, encContext *EncryptionContextValue) (*DecryptedCredential, error) {
+	delay := kmsRetryBaseDelay
+
+	for attempt := 0; ; attempt++ {
+		dcred, err := decryptCredential(cred, encContext)
+		if !isThrottlingError(err) || attempt == kmsMaxRetries {
+			return dcred, err
+		}
+
+		log.WithFields(log.Fields{"name": cred.Name, "attempt": attempt + 1}).Debug("KMS throttled, backing off")
+
+		time.Sleep(delay + time.Duration(rand.Int63n(int64(delay))))
+		delay *= 2
+	}
+}
+
+// batchGetCredentials fetch the items for the supplied keys using BatchGetItem, retrying
+// any unprocessed keys with backoff
+func batchGetCredentials(tableName *string, keys []map[string]*dynamodb.AttributeValue) ([]*Credential, error) {
+
+	var items []map[string]*dynamodb.AttributeValue
+
+	for start := 0; start < len(keys); start += batchGetMaxKeys {
+		end := start + batchGetMaxKeys
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		requestItems := map[string]*dynamodb.KeysAndAttributes{
+			*tableName: {
+				Keys:           keys[start:end],
+				ConsistentRead: aws.Bool(true),
+			},
+		}
+
+		delay := batchGetRetryBaseDelay
+
+		for attempt := 0; len(requestItems) > 0; attempt++ {
+			if attempt > batchGetMaxRetries {
+				return nil, ErrUnprocessedKeys
+			}
+
+			if attempt > 0 {
+				log.WithField("attempt", attempt).Debug("Retrying unprocessed keys")
+				time.Sleep(delay)
+				delay *= 2
+			}
+
+			res, err := dynamoSvc.BatchGetItem(&dynamodb.BatchGetItemInput{
+				RequestItems: requestItems,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			items = append(items, res.Responses[*tableName]...)
+			requestItems = res.UnprocessedKeys
+		}
+	}
+
+	return decodeCredential(items)
+}
+
 func decodeCredential(items []map[string]*dynamodb.AttributeValue) ([]*Credential, error) {
 
 	results := make([]*Credential, 0, len(items))
@@ -638,27 +1030,3 @@ func waitForTable(tableName *string) error {
 	}
 
 }
-
-func getRegion() (*string, error) {
-	// Use meta-data to get our region
-	timeout := time.Duration(5 * time.Second)
-	client := http.Client{
-		Timeout: timeout,
-	}
-
-	response, err := client.Get(zoneURL)
-	if err != nil {
-		log.WithField("err", err).Debug("Request instance region")
-		return nil, nil
-	}
-
-	defer response.Body.Close()
-	contents, err := ioutil.ReadAll(response.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	// Strip last char
-	r := string(contents[0 : len(string(contents))-1])
-	return &r, nil
-}
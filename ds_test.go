@@ -1,6 +1,7 @@
 package unicreds
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/apex/log"
@@ -201,6 +202,299 @@ func TestGetAllSecretsEncryptionContextFailed(t *testing.T) {
 	assert.Len(t, ds, 0)
 }
 
+func TestGetAllSecretsBadHmac(t *testing.T) {
+
+	dsMock, kmsMock := configureMock()
+
+	items := []map[string]*dynamodb.AttributeValue{
+		itemsFixture[0],
+		{
+			"name":     {S: aws.String("tampered")},
+			"version":  {S: aws.String("1")},
+			"contents": {S: aws.String("o8we1zr9GD+KstVv3x2YTeT2")},
+			"hmac":     {S: aws.String("0000485cf52ec57d9db5c05eda678b45eee8d3dabcc6c1ee7c0999712026f6aa")},
+		},
+	}
+
+	qs := &dynamodb.ScanOutput{
+		Count: aws.Int64(int64(len(items))),
+		Items: items,
+	}
+
+	ki := &kms.DecryptOutput{Plaintext: dsPlainText}
+
+	dsMock.On("Scan", mock.AnythingOfType("*dynamodb.ScanInput")).Return(qs, nil)
+	kmsMock.On("Decrypt", mock.AnythingOfType("*kms.DecryptInput")).Return(ki, nil)
+
+	ds, err := GetAllSecrets(&tableName, false, NewEncryptionContextValue())
+
+	assert.Nil(t, err)
+	assert.Len(t, ds, 1)
+	assert.Equal(t, "test", ds[0].Name)
+}
+
+func TestGetAllSecretsConcurrent(t *testing.T) {
+
+	dsMock, kmsMock := configureMock()
+
+	var items []map[string]*dynamodb.AttributeValue
+
+	for _, name := range []string{"delta", "alpha", "charlie", "echo", "bravo"} {
+		items = append(items, map[string]*dynamodb.AttributeValue{
+			"name":     {S: aws.String(name)},
+			"version":  {S: aws.String("1")},
+			"contents": {S: aws.String("o8we1zr9GD+KstVv3x2YTeT2")},
+			"hmac":     {S: aws.String("1e2d485cf52ec57d9db5c05eda678b45eee8d3dabcc6c1ee7c0999712026f6aa")},
+		})
+	}
+
+	qs := &dynamodb.ScanOutput{
+		Count: aws.Int64(int64(len(items))),
+		Items: items,
+	}
+
+	ki := &kms.DecryptOutput{Plaintext: dsPlainText}
+
+	dsMock.On("Scan", mock.AnythingOfType("*dynamodb.ScanInput")).Return(qs, nil)
+	kmsMock.On("Decrypt", mock.AnythingOfType("*kms.DecryptInput")).Return(ki, nil)
+
+	SetDecryptConcurrency(3)
+	defer SetDecryptConcurrency(DefaultDecryptConcurrency)
+
+	ds, err := GetAllSecrets(&tableName, false, NewEncryptionContextValue())
+
+	assert.Nil(t, err)
+	assert.Len(t, ds, 5)
+
+	for i, name := range []string{"alpha", "bravo", "charlie", "delta", "echo"} {
+		assert.Equal(t, name, ds[i].Name)
+		assert.Equal(t, "something test 123", ds[i].Secret)
+	}
+}
+
+func TestGetAllSecretsThrottled(t *testing.T) {
+
+	dsMock, kmsMock := configureMock()
+
+	qs := &dynamodb.ScanOutput{
+		Count: aws.Int64(0),
+		Items: itemsFixture,
+	}
+
+	ki := &kms.DecryptOutput{Plaintext: dsPlainText}
+	awsErr := awserr.New("ThrottlingException", "Rate exceeded", nil)
+
+	dsMock.On("Scan", mock.AnythingOfType("*dynamodb.ScanInput")).Return(qs, nil)
+	kmsMock.On("Decrypt", mock.AnythingOfType("*kms.DecryptInput")).Return(nil, awsErr).Once()
+	kmsMock.On("Decrypt", mock.AnythingOfType("*kms.DecryptInput")).Return(ki, nil)
+
+	ds, err := GetAllSecrets(&tableName, false, NewEncryptionContextValue())
+
+	assert.Nil(t, err)
+	assert.Len(t, ds, 1)
+	kmsMock.AssertNumberOfCalls(t, "Decrypt", 2)
+}
+
+func TestGetSecrets(t *testing.T) {
+
+	dsMock, kmsMock := configureMock()
+
+	queryName := func(name string) interface{} {
+		return mock.MatchedBy(func(in *dynamodb.QueryInput) bool {
+			return aws.StringValue(in.ExpressionAttributeValues[":name"].S) == name
+		})
+	}
+
+	qi := &dynamodb.QueryOutput{
+		Items: []map[string]*dynamodb.AttributeValue{
+			{"version": {S: aws.String("1")}},
+		},
+	}
+
+	unprocessed := &dynamodb.BatchGetItemOutput{
+		Responses: map[string][]map[string]*dynamodb.AttributeValue{},
+		UnprocessedKeys: map[string]*dynamodb.KeysAndAttributes{
+			tableName: {Keys: []map[string]*dynamodb.AttributeValue{
+				{"name": {S: aws.String("test")}, "version": {S: aws.String("1")}},
+			}},
+		},
+	}
+
+	bi := &dynamodb.BatchGetItemOutput{
+		Responses: map[string][]map[string]*dynamodb.AttributeValue{
+			tableName: itemsFixture,
+		},
+	}
+
+	ki := &kms.DecryptOutput{Plaintext: dsPlainText}
+
+	dsMock.On("Query", queryName("test")).Return(qi, nil)
+	dsMock.On("Query", queryName("missing")).Return(&dynamodb.QueryOutput{}, nil)
+	dsMock.On("BatchGetItem", mock.AnythingOfType("*dynamodb.BatchGetItemInput")).Return(unprocessed, nil).Once()
+	dsMock.On("BatchGetItem", mock.AnythingOfType("*dynamodb.BatchGetItemInput")).Return(bi, nil)
+	kmsMock.On("Decrypt", mock.AnythingOfType("*kms.DecryptInput")).Return(ki, nil)
+
+	ds, missing, err := GetSecrets(&tableName, []string{"test", "missing", "test"}, NewEncryptionContextValue())
+
+	assert.Nil(t, err)
+	assert.Len(t, ds, 1)
+	assert.Equal(t, "something test 123", ds["test"].Secret)
+	assert.Equal(t, []string{"missing"}, missing)
+	dsMock.AssertNumberOfCalls(t, "Query", 2)
+	dsMock.AssertNumberOfCalls(t, "BatchGetItem", 2)
+}
+
+func TestGetSecretsScansVersions(t *testing.T) {
+
+	dsMock, kmsMock := configureMock()
+
+	names := []string{"test"}
+	for i := 0; i <= scanVersionsThreshold; i++ {
+		names = append(names, fmt.Sprintf("missing%d", i))
+	}
+
+	qs := &dynamodb.ScanOutput{
+		Items: []map[string]*dynamodb.AttributeValue{
+			{"name": {S: aws.String("test")}, "version": {S: aws.String("1")}},
+			{"name": {S: aws.String("other")}, "version": {S: aws.String("3")}},
+		},
+	}
+
+	bi := &dynamodb.BatchGetItemOutput{
+		Responses: map[string][]map[string]*dynamodb.AttributeValue{
+			tableName: itemsFixture,
+		},
+	}
+
+	ki := &kms.DecryptOutput{Plaintext: dsPlainText}
+
+	dsMock.On("Scan", mock.AnythingOfType("*dynamodb.ScanInput")).Return(qs, nil)
+	dsMock.On("BatchGetItem", mock.MatchedBy(func(in *dynamodb.BatchGetItemInput) bool {
+		return len(in.RequestItems[tableName].Keys) == 1
+	})).Return(bi, nil)
+	kmsMock.On("Decrypt", mock.AnythingOfType("*kms.DecryptInput")).Return(ki, nil)
+
+	ds, missing, err := GetSecrets(&tableName, names, NewEncryptionContextValue())
+
+	assert.Nil(t, err)
+	assert.Len(t, ds, 1)
+	assert.Equal(t, "something test 123", ds["test"].Secret)
+	assert.Equal(t, names[1:], missing)
+	dsMock.AssertNumberOfCalls(t, "Query", 0)
+	dsMock.AssertNumberOfCalls(t, "Scan", 1)
+}
+
+func TestPutSecretRecordsContext(t *testing.T) {
+
+	dsMock, kmsMock := configureMock()
+
+	dko := &kms.GenerateDataKeyOutput{
+		Plaintext:      append(append([]byte{}, dsPlainText...), dsPlainText...),
+		CiphertextBlob: []byte("wrapped"),
+	}
+
+	kmsMock.On("GenerateDataKey", mock.AnythingOfType("*kms.GenerateDataKeyInput")).Return(dko, nil)
+	dsMock.On("PutItem", mock.MatchedBy(func(input *dynamodb.PutItemInput) bool {
+		keys := input.Item["context_keys"]
+		context := input.Item["context"]
+		return keys != nil && len(keys.SS) == 2 && aws.StringValue(keys.SS[0]) == "env" &&
+			context != nil && aws.StringValue(context.M["stack"].S) == "api"
+	})).Return(&dynamodb.PutItemOutput{}, nil)
+
+	encContext := NewEncryptionContextValue()
+	encContext.SetMap(map[string]string{"stack": "api", "env": "prod"})
+
+	err := PutSecretWithOptions(&tableName, "", "test", "something test 123", "1", encContext, &PutOptions{RecordContextValues: true})
+
+	assert.Nil(t, err)
+	dsMock.AssertExpectations(t)
+}
+
+func TestPutSecretWithoutRecordContext(t *testing.T) {
+
+	dsMock, kmsMock := configureMock()
+
+	dko := &kms.GenerateDataKeyOutput{
+		Plaintext:      append(append([]byte{}, dsPlainText...), dsPlainText...),
+		CiphertextBlob: []byte("wrapped"),
+	}
+
+	kmsMock.On("GenerateDataKey", mock.AnythingOfType("*kms.GenerateDataKeyInput")).Return(dko, nil)
+	dsMock.On("PutItem", mock.MatchedBy(func(input *dynamodb.PutItemInput) bool {
+		_, hasKeys := input.Item["context_keys"]
+		_, hasContext := input.Item["context"]
+		return !hasKeys && !hasContext
+	})).Return(&dynamodb.PutItemOutput{}, nil)
+
+	encContext := NewEncryptionContextValue()
+	encContext.SetMap(map[string]string{"stack": "api"})
+
+	err := PutSecret(&tableName, "", "test", "something test 123", "1", encContext)
+
+	assert.Nil(t, err)
+	dsMock.AssertExpectations(t)
+}
+
+func TestPutSecretBinary(t *testing.T) {
+
+	dsMock, kmsMock := configureMock()
+
+	dko := &kms.GenerateDataKeyOutput{
+		Plaintext:      append(append([]byte{}, dsPlainText...), dsPlainText...),
+		CiphertextBlob: []byte("wrapped"),
+	}
+
+	secret := string([]byte{0x00, 0xff, 0xfe, 0x0a, 0x80})
+
+	kmsMock.On("GenerateDataKey", mock.AnythingOfType("*kms.GenerateDataKeyInput")).Return(dko, nil)
+	dsMock.On("PutItem", mock.MatchedBy(func(input *dynamodb.PutItemInput) bool {
+		return input.Item["binary"] != nil && aws.BoolValue(input.Item["binary"].BOOL)
+	})).Return(&dynamodb.PutItemOutput{}, nil)
+
+	err := PutSecretWithOptions(&tableName, "", "test", secret, "1", NewEncryptionContextValue(), &PutOptions{Binary: true})
+	assert.Nil(t, err)
+
+	item := dsMock.Calls[0].Arguments.Get(0).(*dynamodb.PutItemInput).Item
+
+	kmsMock.On("Decrypt", mock.AnythingOfType("*kms.DecryptInput")).Return(&kms.DecryptOutput{Plaintext: dko.Plaintext}, nil)
+	dsMock.On("GetItem", mock.AnythingOfType("*dynamodb.GetItemInput")).Return(&dynamodb.GetItemOutput{Item: item}, nil)
+
+	ds, err := GetSecret(&tableName, "test", "1", NewEncryptionContextValue())
+
+	assert.Nil(t, err)
+	assert.True(t, ds.Binary)
+	assert.Equal(t, []byte(secret), []byte(ds.Secret))
+}
+
+func TestGetSecretStoredContext(t *testing.T) {
+
+	dsMock, kmsMock := configureMock()
+
+	item := map[string]*dynamodb.AttributeValue{
+		"name":         &dynamodb.AttributeValue{S: aws.String("test")},
+		"version":      &dynamodb.AttributeValue{S: aws.String("1")},
+		"contents":     &dynamodb.AttributeValue{S: aws.String("o8we1zr9GD+KstVv3x2YTeT2")},
+		"hmac":         &dynamodb.AttributeValue{S: aws.String("1e2d485cf52ec57d9db5c05eda678b45eee8d3dabcc6c1ee7c0999712026f6aa")},
+		"context_keys": &dynamodb.AttributeValue{SS: []*string{aws.String("stack")}},
+		"context": &dynamodb.AttributeValue{M: map[string]*dynamodb.AttributeValue{
+			"stack": &dynamodb.AttributeValue{S: aws.String("api")},
+		}},
+	}
+
+	ki := &kms.DecryptOutput{Plaintext: dsPlainText}
+
+	dsMock.On("GetItem", mock.AnythingOfType("*dynamodb.GetItemInput")).Return(&dynamodb.GetItemOutput{Item: item}, nil)
+	kmsMock.On("Decrypt", mock.MatchedBy(func(input *kms.DecryptInput) bool {
+		return aws.StringValue(input.EncryptionContext["stack"]) == "api"
+	})).Return(ki, nil)
+
+	ds, err := GetSecret(&tableName, "test", "1", NewEncryptionContextValue())
+
+	assert.Nil(t, err)
+	assert.Equal(t, ds.Secret, "something test 123")
+	assert.Equal(t, []string{"stack"}, ds.ContextKeys)
+}
+
 func TestListSecrets(t *testing.T) {
 
 	dsMock, _ := configureMock()
@@ -218,6 +512,29 @@ func TestListSecrets(t *testing.T) {
 	assert.Len(t, ds, 1)
 }
 
+func TestDeleteSecretPaginated(t *testing.T) {
+
+	dsMock, _ := configureMock()
+
+	dsMock.On("Query", mock.MatchedBy(func(input *dynamodb.QueryInput) bool {
+		return input.ExclusiveStartKey == nil
+	})).Return(&dynamodb.QueryOutput{
+		Items:            []map[string]*dynamodb.AttributeValue{historyItem(PaddedInt(2))},
+		LastEvaluatedKey: historyItem(PaddedInt(2)),
+	}, nil)
+	dsMock.On("Query", mock.MatchedBy(func(input *dynamodb.QueryInput) bool {
+		return input.ExclusiveStartKey != nil
+	})).Return(&dynamodb.QueryOutput{
+		Items: []map[string]*dynamodb.AttributeValue{historyItem(PaddedInt(1))},
+	}, nil)
+	dsMock.On("DeleteItem", mock.AnythingOfType("*dynamodb.DeleteItemInput")).Return(&dynamodb.DeleteItemOutput{}, nil)
+
+	err := DeleteSecret(&tableName, "test")
+
+	assert.Nil(t, err)
+	dsMock.AssertNumberOfCalls(t, "DeleteItem", 2)
+}
+
 func configureMock() (*mocks.DynamoDBAPI, *mocks.KMSAPI) {
 	dsMock := &mocks.DynamoDBAPI{}
 	kmsMock := &mocks.KMSAPI{}
@@ -1,8 +1,12 @@
 package unicreds
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"strings"
+
+	yaml "gopkg.in/yaml.v2"
 )
 
 // EncryptionContextValue key value with helper methods for flag parser
@@ -32,3 +36,61 @@ func (h *EncryptionContextValue) String() string {
 func (h *EncryptionContextValue) IsCumulative() bool {
 	return true
 }
+
+// Parse adds the pairs from either a JSON object or a comma separated list of KEY:VALUE pairs
+func (h *EncryptionContextValue) Parse(value string) error {
+	value = strings.TrimSpace(value)
+
+	if strings.HasPrefix(value, "{") {
+		pairs := map[string]string{}
+		if err := json.Unmarshal([]byte(value), &pairs); err != nil {
+			return fmt.Errorf("invalid encryption context: %v", err)
+		}
+		h.SetMap(pairs)
+		return nil
+	}
+
+	for _, pair := range strings.Split(value, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		if err := h.Set(pair); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LoadFile adds the pairs from a JSON or YAML file containing a single object
+func (h *EncryptionContextValue) LoadFile(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	// yaml is a superset of json so this handles both
+	pairs := map[string]string{}
+	if err := yaml.Unmarshal(data, &pairs); err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+
+	h.SetMap(pairs)
+	return nil
+}
+
+// SetMap adds each of the supplied pairs
+func (h *EncryptionContextValue) SetMap(pairs map[string]string) {
+	for k, v := range pairs {
+		value := v
+		(*h)[k] = &value
+	}
+}
+
+// Merge adds any pairs from other whose keys aren't already present
+func (h *EncryptionContextValue) Merge(other *EncryptionContextValue) {
+	for k, v := range *other {
+		if _, ok := (*h)[k]; !ok {
+			(*h)[k] = v
+		}
+	}
+}
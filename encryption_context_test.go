@@ -1,8 +1,12 @@
 package unicreds
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -23,3 +27,53 @@ func TestIsCumulative(t *testing.T) {
 
 	assert.Equal(t, true, encContext.IsCumulative())
 }
+
+func TestParse(t *testing.T) {
+	encContext := NewEncryptionContextValue()
+
+	err := encContext.Parse("stack:123, team:platform")
+	assert.Nil(t, err)
+	assert.Equal(t, "123", aws.StringValue((*encContext)["stack"]))
+	assert.Equal(t, "platform", aws.StringValue((*encContext)["team"]))
+
+	err = encContext.Parse(`{"app": "billing"}`)
+	assert.Nil(t, err)
+	assert.Equal(t, "billing", aws.StringValue((*encContext)["app"]))
+
+	err = encContext.Parse("booo")
+	assert.Error(t, err)
+}
+
+func TestLoadFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "unicreds")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	jsonPath := filepath.Join(dir, "context.json")
+	ioutil.WriteFile(jsonPath, []byte(`{"stack": "123"}`), 0600)
+
+	yamlPath := filepath.Join(dir, "context.yaml")
+	ioutil.WriteFile(yamlPath, []byte("team: platform\n"), 0600)
+
+	encContext := NewEncryptionContextValue()
+
+	assert.Nil(t, encContext.LoadFile(jsonPath))
+	assert.Nil(t, encContext.LoadFile(yamlPath))
+	assert.Equal(t, "123", aws.StringValue((*encContext)["stack"]))
+	assert.Equal(t, "platform", aws.StringValue((*encContext)["team"]))
+
+	assert.Error(t, encContext.LoadFile(filepath.Join(dir, "missing.json")))
+}
+
+func TestMerge(t *testing.T) {
+	encContext := NewEncryptionContextValue()
+	encContext.Set("stack:flag")
+
+	other := NewEncryptionContextValue()
+	other.SetMap(map[string]string{"stack": "file", "team": "platform"})
+
+	encContext.Merge(other)
+
+	assert.Equal(t, "flag", aws.StringValue((*encContext)["stack"]))
+	assert.Equal(t, "platform", aws.StringValue((*encContext)["team"]))
+}
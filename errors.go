@@ -0,0 +1,97 @@
+package unicreds
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/kms"
+)
+
+// Error codes identifying the kind of failure, these are stable so scripts can rely on them
+const (
+	ErrorCodeUnknown        = "error"
+	ErrorCodeNotFound       = "not_found"
+	ErrorCodeHmacValidation = "hmac_validation_failed"
+	ErrorCodeAccessDenied   = "access_denied"
+	ErrorCodeInvalidContext = "invalid_context"
+	ErrorCodeConflict       = "conflict"
+	ErrorCodeThrottled      = "throttled"
+	ErrorCodeTimeout        = "timeout"
+)
+
+// exitCodes process exit code for each error code, these must not be renumbered
+var exitCodes = map[string]int{
+	ErrorCodeUnknown:        1,
+	ErrorCodeNotFound:       2,
+	ErrorCodeHmacValidation: 3,
+	ErrorCodeAccessDenied:   4,
+	ErrorCodeInvalidContext: 5,
+	ErrorCodeConflict:       6,
+	ErrorCodeThrottled:      7,
+	ErrorCodeTimeout:        8,
+}
+
+// ErrorCode returns the code for the kind of failure, or ErrorCodeUnknown if it isn't one
+// which callers are likely to handle differently
+func ErrorCode(err error) string {
+	switch {
+	case errors.Is(err, ErrSecretNotFound):
+		return ErrorCodeNotFound
+	case errors.Is(err, ErrHmacValidationFailed):
+		return ErrorCodeHmacValidation
+	case errors.Is(err, ErrTimeout):
+		return ErrorCodeTimeout
+	case errors.Is(err, ErrUnprocessedKeys):
+		return ErrorCodeThrottled
+	}
+
+	var contextErr *ContextPolicyError
+	if errors.As(err, &contextErr) {
+		return ErrorCodeInvalidContext
+	}
+
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		switch code := awsErr.Code(); {
+		case code == "AccessDeniedException" || code == "AccessDenied":
+			return ErrorCodeAccessDenied
+		case code == kms.ErrCodeInvalidCiphertextException:
+			return ErrorCodeInvalidContext
+		case code == dynamodb.ErrCodeConditionalCheckFailedException || code == dynamodb.ErrCodeTransactionConflictException:
+			return ErrorCodeConflict
+		case request.IsErrorThrottle(awsErr):
+			return ErrorCodeThrottled
+		case isTimeout(awsErr.OrigErr()):
+			// requests canceled by a deadline are timeouts, other cancellations aren't
+			return ErrorCodeTimeout
+		}
+	}
+
+	if isTimeout(err) {
+		return ErrorCodeTimeout
+	}
+
+	return ErrorCodeUnknown
+}
+
+// ExitCode returns the process exit code for the kind of failure
+func ExitCode(err error) int {
+	return exitCodes[ErrorCode(err)]
+}
+
+func isTimeout(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,48 @@
+package unicreds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/stretchr/testify/assert"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		code string
+		exit int
+	}{
+		{errors.New("boom"), ErrorCodeUnknown, 1},
+		{ErrSecretNotFound, ErrorCodeNotFound, 2},
+		{ErrHmacValidationFailed, ErrorCodeHmacValidation, 3},
+		{awserr.New("AccessDeniedException", "KMS Access Denied to decrypt", nil), ErrorCodeAccessDenied, 4},
+		{awserr.New("InvalidCiphertextException", "context mismatch", nil), ErrorCodeInvalidContext, 5},
+		{&ContextPolicyError{Name: "prod/db", Missing: []string{"env"}}, ErrorCodeInvalidContext, 5},
+		{awserr.New("ConditionalCheckFailedException", "exists", nil), ErrorCodeConflict, 6},
+		{awserr.New("ProvisionedThroughputExceededException", "slow down", nil), ErrorCodeThrottled, 7},
+		{awserr.New("ThrottlingException", "slow down", nil), ErrorCodeThrottled, 7},
+		{ErrUnprocessedKeys, ErrorCodeThrottled, 7},
+		{ErrTimeout, ErrorCodeTimeout, 8},
+		{awserr.New(request.CanceledErrorCode, "canceled", context.DeadlineExceeded), ErrorCodeTimeout, 8},
+		{awserr.New(request.CanceledErrorCode, "canceled", context.Canceled), ErrorCodeUnknown, 1},
+		{awserr.New(request.ErrCodeRequestError, "send request failed", &url.Error{Op: "Post", URL: "https://dynamodb", Err: timeoutError{}}), ErrorCodeTimeout, 8},
+		{fmt.Errorf("prod/db: %w", ErrSecretNotFound), ErrorCodeNotFound, 2},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.code, ErrorCode(tt.err), tt.err.Error())
+		assert.Equal(t, tt.exit, ExitCode(tt.err), tt.err.Error())
+	}
+}
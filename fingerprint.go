@@ -0,0 +1,99 @@
+package unicreds
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+)
+
+// fingerprintLength number of hex characters of the hmac kept in a fingerprint
+const fingerprintLength = 16
+
+// Fingerprinter returns a fingerprint of the secret
+type Fingerprinter func(secret string) (string, error)
+
+// DuplicateGroup names of secrets which share a value
+type DuplicateGroup struct {
+	Fingerprint string
+	Names       []string
+}
+
+// NewFingerprintKey returns a random key, fingerprints made with it can only be compared with
+// others made with the same key so they can't be used to guess secrets offline
+func NewFingerprintKey() ([]byte, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Fingerprint returns a keyed hash of the secret which identifies identical values without
+// revealing them
+func Fingerprint(key []byte, secret string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(secret))
+	return hex.EncodeToString(mac.Sum(nil))[:fingerprintLength]
+}
+
+// KeyFingerprinter returns a fingerprinter using the supplied key
+func KeyFingerprinter(key []byte) Fingerprinter {
+	return func(secret string) (string, error) {
+		return Fingerprint(key, secret), nil
+	}
+}
+
+// KMSFingerprinter returns a fingerprinter using a KMS HMAC key, the fingerprints are stable
+// between runs and can only be made by callers allowed to use the key
+func KMSFingerprinter(keyID string) Fingerprinter {
+	return func(secret string) (string, error) {
+		// kms limits the message size so the digest of the secret is signed
+		digest := sha256.Sum256([]byte(secret))
+
+		resp, err := kmsSvc.GenerateMac(&kms.GenerateMacInput{
+			KeyId:        aws.String(keyID),
+			MacAlgorithm: aws.String(kms.MacAlgorithmSpecHmacSha256),
+			Message:      digest[:],
+		})
+		if err != nil {
+			return "", err
+		}
+
+		return hex.EncodeToString(resp.Mac)[:fingerprintLength], nil
+	}
+}
+
+// FindDuplicates group the secrets which share a value, secrets with unique values are
+// left out
+func FindDuplicates(creds []*DecryptedCredential, fingerprint Fingerprinter) ([]*DuplicateGroup, error) {
+	names := map[string][]string{}
+
+	for _, cred := range creds {
+		fp, err := fingerprint(cred.Secret)
+		if err != nil {
+			return nil, err
+		}
+		names[fp] = append(names[fp], cred.Name)
+	}
+
+	var groups []*DuplicateGroup
+
+	for fp, group := range names {
+		if len(group) < 2 {
+			continue
+		}
+		sort.Strings(group)
+		groups = append(groups, &DuplicateGroup{Fingerprint: fp, Names: group})
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Names[0] < groups[j].Names[0]
+	})
+
+	return groups, nil
+}
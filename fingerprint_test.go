@@ -0,0 +1,60 @@
+package unicreds
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFingerprint(t *testing.T) {
+	key, err := NewFingerprintKey()
+	assert.Nil(t, err)
+
+	other, err := NewFingerprintKey()
+	assert.Nil(t, err)
+
+	assert.Len(t, Fingerprint(key, "secret"), fingerprintLength)
+	assert.Equal(t, Fingerprint(key, "secret"), Fingerprint(key, "secret"))
+	assert.NotEqual(t, Fingerprint(key, "secret"), Fingerprint(key, "secret2"))
+	assert.NotEqual(t, Fingerprint(key, "secret"), Fingerprint(other, "secret"))
+}
+
+func TestKMSFingerprinter(t *testing.T) {
+
+	_, kmsMock := configureMock()
+
+	kmsMock.On("GenerateMac", mock.MatchedBy(func(input *kms.GenerateMacInput) bool {
+		return aws.StringValue(input.KeyId) == "alias/fingerprint" && len(input.Message) == sha256.Size
+	})).Return(&kms.GenerateMacOutput{Mac: bytes.Repeat([]byte{0xab}, 32)}, nil)
+
+	fp, err := KMSFingerprinter("alias/fingerprint")("secret")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "abababababababab", fp)
+}
+
+func TestFindDuplicates(t *testing.T) {
+	key, err := NewFingerprintKey()
+	assert.Nil(t, err)
+
+	creds := []*DecryptedCredential{
+		{Credential: &Credential{Name: "svc-b/password"}, Secret: "password123"},
+		{Credential: &Credential{Name: "svc-a/password"}, Secret: "password123"},
+		{Credential: &Credential{Name: "svc-c/password"}, Secret: "unique"},
+		{Credential: &Credential{Name: "svc-d/token"}, Secret: "shared"},
+		{Credential: &Credential{Name: "svc-e/token"}, Secret: "shared"},
+	}
+
+	groups, err := FindDuplicates(creds, KeyFingerprinter(key))
+
+	assert.Nil(t, err)
+	assert.Len(t, groups, 2)
+	assert.Equal(t, []string{"svc-a/password", "svc-b/password"}, groups[0].Names)
+	assert.Equal(t, []string{"svc-d/token", "svc-e/token"}, groups[1].Names)
+	assert.Equal(t, Fingerprint(key, "password123"), groups[0].Fingerprint)
+}
@@ -0,0 +1,70 @@
+package unicreds
+
+import (
+	"encoding/base64"
+
+	"github.com/apex/log"
+)
+
+// HistoryVersion a stored version of a secret, the secret is only set when the history is
+// decrypted
+type HistoryVersion struct {
+	*Credential
+
+	// Size of the stored contents in bytes, after any compression
+	Size int
+
+	Secret string
+	// Err set when decrypting this version failed, such as when it was stored with another
+	// encryption context or a key which can no longer be used
+	Err error
+}
+
+// GetSecretHistory returns every version of the named secret, oldest first. Versions are
+// only decrypted when requested, which takes a KMS call for each, and a version which can't
+// be decrypted has its error set rather than failing the whole history.
+func GetSecretHistory(tableName *string, name string, decrypt bool, encContext *EncryptionContextValue) ([]*HistoryVersion, error) {
+	log.WithField("name", name).Debug("Getting secret history")
+
+	creds, err := queryVersions(tableName, name, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(creds) == 0 {
+		return nil, ErrSecretNotFound
+	}
+
+	versions := make([]*HistoryVersion, len(creds))
+
+	for i, cred := range creds {
+		if err = assembleChunks(tableName, cred); err != nil {
+			return nil, err
+		}
+
+		// CTR mode ciphertext is the same length as the stored plaintext
+		contents, err := base64.StdEncoding.DecodeString(cred.Contents)
+		if err != nil {
+			return nil, err
+		}
+
+		versions[i] = &HistoryVersion{Credential: cred, Size: len(contents)}
+	}
+
+	if !decrypt {
+		return versions, nil
+	}
+
+	decrypted, errs := decryptCredentials(creds, encContext)
+
+	for i, err := range errs {
+		if err != nil {
+			log.WithFields(log.Fields{"name": name, "version": creds[i].Version}).WithError(err).Debug("Unable to decrypt version")
+			versions[i].Err = err
+			continue
+		}
+		versions[i].Secret = decrypted[i].Secret
+	}
+
+	return versions, nil
+}
@@ -0,0 +1,109 @@
+package unicreds
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func historyItem(version string) map[string]*dynamodb.AttributeValue {
+	return map[string]*dynamodb.AttributeValue{
+		"name":     {S: aws.String("test")},
+		"version":  {S: aws.String(version)},
+		"contents": {S: aws.String("o8we1zr9GD+KstVv3x2YTeT2")},
+		"hmac":     {S: aws.String("1e2d485cf52ec57d9db5c05eda678b45eee8d3dabcc6c1ee7c0999712026f6aa")},
+	}
+}
+
+func TestGetSecretHistory(t *testing.T) {
+
+	dsMock, kmsMock := configureMock()
+
+	page1 := &dynamodb.QueryOutput{
+		Items:            []map[string]*dynamodb.AttributeValue{historyItem(PaddedInt(1))},
+		LastEvaluatedKey: historyItem(PaddedInt(1)),
+	}
+	page2 := &dynamodb.QueryOutput{
+		Items: []map[string]*dynamodb.AttributeValue{historyItem(PaddedInt(2))},
+	}
+
+	dsMock.On("Query", mock.MatchedBy(func(input *dynamodb.QueryInput) bool {
+		return input.ExclusiveStartKey == nil && aws.BoolValue(input.ScanIndexForward)
+	})).Return(page1, nil)
+	dsMock.On("Query", mock.MatchedBy(func(input *dynamodb.QueryInput) bool {
+		return input.ExclusiveStartKey != nil
+	})).Return(page2, nil)
+
+	keyArn := "arn:aws:kms:us-west-2:123456789012:key/abc"
+	kmsMock.On("Decrypt", mock.AnythingOfType("*kms.DecryptInput")).Return(&kms.DecryptOutput{Plaintext: dsPlainText, KeyId: aws.String(keyArn)}, nil)
+
+	history, err := GetSecretHistory(&tableName, "test", true, NewEncryptionContextValue())
+
+	assert.Nil(t, err)
+	assert.Len(t, history, 2)
+	assert.Equal(t, PaddedInt(1), history[0].Version)
+	assert.Equal(t, PaddedInt(2), history[1].Version)
+	assert.Equal(t, keyArn, history[1].KeyArn)
+	assert.Equal(t, "something test 123", history[1].Secret)
+	assert.Equal(t, len("something test 123"), history[1].Size)
+	assert.Nil(t, history[1].Err)
+}
+
+func TestGetSecretHistoryWithoutDecrypting(t *testing.T) {
+
+	dsMock, kmsMock := configureMock()
+
+	dsMock.On("Query", mock.AnythingOfType("*dynamodb.QueryInput")).Return(&dynamodb.QueryOutput{
+		Items: []map[string]*dynamodb.AttributeValue{historyItem(PaddedInt(1)), historyItem(PaddedInt(2))},
+	}, nil)
+
+	history, err := GetSecretHistory(&tableName, "test", false, NewEncryptionContextValue())
+
+	assert.Nil(t, err)
+	assert.Len(t, history, 2)
+	assert.Equal(t, len("something test 123"), history[0].Size)
+	assert.Equal(t, "", history[0].Secret)
+	kmsMock.AssertNotCalled(t, "Decrypt", mock.Anything)
+}
+
+func TestGetSecretHistoryUndecryptableVersion(t *testing.T) {
+
+	dsMock, kmsMock := configureMock()
+
+	old := historyItem(PaddedInt(1))
+	old["key"] = &dynamodb.AttributeValue{S: aws.String("b2xkLWtleQ==")}
+
+	dsMock.On("Query", mock.AnythingOfType("*dynamodb.QueryInput")).Return(&dynamodb.QueryOutput{
+		Items: []map[string]*dynamodb.AttributeValue{old, historyItem(PaddedInt(2))},
+	}, nil)
+
+	kmsMock.On("Decrypt", mock.MatchedBy(func(input *kms.DecryptInput) bool {
+		return string(input.CiphertextBlob) == "old-key"
+	})).Return(nil, awserr.New(kms.ErrCodeInvalidCiphertextException, "context mismatch", nil))
+	kmsMock.On("Decrypt", mock.AnythingOfType("*kms.DecryptInput")).Return(&kms.DecryptOutput{Plaintext: dsPlainText}, nil)
+
+	history, err := GetSecretHistory(&tableName, "test", true, NewEncryptionContextValue())
+
+	assert.Nil(t, err)
+	assert.Len(t, history, 2)
+	assert.NotNil(t, history[0].Err)
+	assert.Equal(t, len("something test 123"), history[0].Size)
+	assert.Nil(t, history[1].Err)
+	assert.Equal(t, "something test 123", history[1].Secret)
+}
+
+func TestGetSecretHistoryNotFound(t *testing.T) {
+
+	dsMock, _ := configureMock()
+
+	dsMock.On("Query", mock.AnythingOfType("*dynamodb.QueryInput")).Return(&dynamodb.QueryOutput{}, nil)
+
+	_, err := GetSecretHistory(&tableName, "test", false, NewEncryptionContextValue())
+
+	assert.Equal(t, ErrSecretNotFound, err)
+}
@@ -0,0 +1,185 @@
+package unicreds
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ExtractField returns the value at the path within a JSON secret, the path is a jq style
+// list of keys and array indexes such as .db.hosts[0]. Strings are returned as is and any
+// other value as JSON.
+func ExtractField(secret, path string) (string, error) {
+	value, err := decodeJSONSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	steps, err := parseFieldPath(path)
+	if err != nil {
+		return "", err
+	}
+
+	for _, step := range steps {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			field, ok := v[step]
+			if !ok {
+				return "", fmt.Errorf("field %q not found", path)
+			}
+			value = field
+		case []interface{}:
+			i, err := strconv.Atoi(step)
+			if err != nil || i < 0 || i >= len(v) {
+				return "", fmt.Errorf("index %s of %q out of range", step, path)
+			}
+			value = v[i]
+		default:
+			return "", fmt.Errorf("field %q not found", path)
+		}
+	}
+
+	return formatJSONValue(value)
+}
+
+// SetField set the string value at the path within a JSON object secret, creating any missing
+// objects along the way, and returns the updated secret. An empty secret is treated as an
+// empty object.
+func SetField(secret, path, value string) (string, error) {
+	if strings.TrimSpace(secret) == "" {
+		secret = "{}"
+	}
+
+	decoded, err := decodeJSONSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	root, ok := decoded.(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("secret is not a JSON object")
+	}
+
+	steps, err := parseFieldPath(path)
+	if err != nil {
+		return "", err
+	}
+	if len(steps) == 0 {
+		return "", fmt.Errorf("a field is required")
+	}
+
+	obj := root
+	for _, step := range steps[:len(steps)-1] {
+		next, ok := obj[step]
+		if !ok {
+			next = map[string]interface{}{}
+			obj[step] = next
+		}
+		if obj, ok = next.(map[string]interface{}); !ok {
+			return "", fmt.Errorf("field %q is not an object", step)
+		}
+	}
+	obj[steps[len(steps)-1]] = value
+
+	return formatJSONValue(root)
+}
+
+// ExpandJSON returns an environment variable for each field of a JSON object secret, named
+// after the secret and the path to the field joined with underscores. Returns false if the
+// secret isn't a JSON object.
+func ExpandJSON(name, secret string) (map[string]string, bool) {
+	decoded, err := decodeJSONSecret(secret)
+	if err != nil {
+		return nil, false
+	}
+
+	obj, ok := decoded.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	vars := map[string]string{}
+	expandJSONObject(name, obj, vars)
+
+	return vars, true
+}
+
+func expandJSONObject(prefix string, obj map[string]interface{}, vars map[string]string) {
+	for key, value := range obj {
+		name := prefix + "_" + key
+
+		if nested, ok := value.(map[string]interface{}); ok {
+			expandJSONObject(name, nested, vars)
+			continue
+		}
+
+		if formatted, err := formatJSONValue(value); err == nil {
+			vars[name] = formatted
+		}
+	}
+}
+
+func decodeJSONSecret(secret string) (interface{}, error) {
+	dec := json.NewDecoder(strings.NewReader(secret))
+	dec.UseNumber() // keep numbers exactly as written
+
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return nil, fmt.Errorf("secret is not valid JSON: %v", err)
+	}
+
+	return value, nil
+}
+
+func formatJSONValue(value interface{}) (string, error) {
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(value); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// parseFieldPath split a path such as .db.hosts[0] or db.password into its steps
+func parseFieldPath(path string) ([]string, error) {
+	var steps []string
+
+	path = strings.TrimPrefix(path, ".")
+
+	for _, part := range strings.Split(path, ".") {
+		if part == "" {
+			continue
+		}
+
+		key := part
+		var indexes []string
+
+		if i := strings.Index(part, "["); i >= 0 {
+			key = part[:i]
+			rest := part[i:]
+			for rest != "" {
+				end := strings.Index(rest, "]")
+				if !strings.HasPrefix(rest, "[") || end < 0 {
+					return nil, fmt.Errorf("invalid field path %q", path)
+				}
+				indexes = append(indexes, rest[1:end])
+				rest = rest[end+1:]
+			}
+		}
+
+		if key != "" {
+			steps = append(steps, key)
+		}
+		steps = append(steps, indexes...)
+	}
+
+	return steps, nil
+}
@@ -0,0 +1,76 @@
+package unicreds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const jsonSecret = `{"host":"db.example.com","port":5432,"user":{"name":"app","password":"s3cr3t"},"replicas":["r1","r2"]}`
+
+func TestExtractField(t *testing.T) {
+	tests := map[string]string{
+		"host":          "db.example.com",
+		".port":         "5432",
+		"user.password": "s3cr3t",
+		".replicas[1]":  "r2",
+		"replicas":      `["r1","r2"]`,
+		"user":          `{"name":"app","password":"s3cr3t"}`,
+	}
+
+	for path, expected := range tests {
+		value, err := ExtractField(jsonSecret, path)
+		assert.Nil(t, err, path)
+		assert.Equal(t, expected, value, path)
+	}
+
+	_, err := ExtractField(jsonSecret, "missing")
+	assert.Error(t, err)
+
+	_, err = ExtractField(jsonSecret, "replicas[5]")
+	assert.Error(t, err)
+
+	_, err = ExtractField("not json", "host")
+	assert.Error(t, err)
+}
+
+func TestSetField(t *testing.T) {
+	secret, err := SetField(jsonSecret, "user.password", "n3w")
+	assert.Nil(t, err)
+
+	value, err := ExtractField(secret, "user.password")
+	assert.Nil(t, err)
+	assert.Equal(t, "n3w", value)
+
+	value, err = ExtractField(secret, "port")
+	assert.Nil(t, err)
+	assert.Equal(t, "5432", value)
+
+	secret, err = SetField("", "db.host", "localhost")
+	assert.Nil(t, err)
+	assert.Equal(t, `{"db":{"host":"localhost"}}`, secret)
+
+	_, err = SetField(`["a"]`, "host", "localhost")
+	assert.Error(t, err)
+
+	_, err = SetField(jsonSecret, "host.name", "localhost")
+	assert.Error(t, err)
+}
+
+func TestExpandJSON(t *testing.T) {
+	vars, ok := ExpandJSON("db", jsonSecret)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]string{
+		"db_host":          "db.example.com",
+		"db_port":          "5432",
+		"db_user_name":     "app",
+		"db_user_password": "s3cr3t",
+		"db_replicas":      `["r1","r2"]`,
+	}, vars)
+
+	_, ok = ExpandJSON("plain", "not json")
+	assert.False(t, ok)
+
+	_, ok = ExpandJSON("list", `["a"]`)
+	assert.False(t, ok)
+}
@@ -1,13 +1,30 @@
 package unicreds
 
 import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 )
 
-var kmsSvc kmsiface.KMSAPI
+const (
+	kmsMaxRetries     = 5
+	kmsRetryBaseDelay = 100 * time.Millisecond
+)
+
+var (
+	kmsSvc kmsiface.KMSAPI
+
+	dkCache *dataKeyCache
+)
 
 func init() {
 	kmsSvc = kms.New(session.New(), aws.NewConfig())
@@ -22,10 +39,21 @@ func SetKMSSession(sess *session.Session) {
 	kmsSvc = kms.New(sess)
 }
 
+// SetDataKeyCache enable an in memory cache of decrypted data keys keyed by the wrapped key
+// and encryption context, a ttl or maxEntries of zero disables the cache
+func SetDataKeyCache(ttl time.Duration, maxEntries int) {
+	if ttl <= 0 || maxEntries <= 0 {
+		dkCache = nil
+		return
+	}
+	dkCache = newDataKeyCache(ttl, maxEntries)
+}
+
 // DataKey which contains the details of the KMS key
 type DataKey struct {
 	CiphertextBlob []byte
 	Plaintext      []byte
+	KeyID          string
 }
 
 // GenerateDataKey simplified method for generating a datakey with kms
@@ -49,12 +77,19 @@ func GenerateDataKey(alias string, encContext *EncryptionContextValue, size int)
 	return &DataKey{
 		CiphertextBlob: resp.CiphertextBlob,
 		Plaintext:      resp.Plaintext, // return the plain text key after generation
+		KeyID:          aws.StringValue(resp.KeyId),
 	}, nil
 }
 
 // DecryptDataKey ask kms to decrypt the supplied data key
 func DecryptDataKey(ciphertext []byte, encContext *EncryptionContextValue) (*DataKey, error) {
 
+	cacheKey := dataKeyCacheKey(ciphertext, encContext)
+
+	if dk, ok := dkCache.get(cacheKey); ok {
+		return dk, nil
+	}
+
 	params := &kms.DecryptInput{
 		CiphertextBlob:    ciphertext,
 		EncryptionContext: *encContext,
@@ -66,8 +101,136 @@ func DecryptDataKey(ciphertext []byte, encContext *EncryptionContextValue) (*Dat
 		return nil, err
 	}
 
-	return &DataKey{
+	dk := &DataKey{
 		CiphertextBlob: ciphertext,
 		Plaintext:      resp.Plaintext, // transfer the plain text key after decryption
-	}, nil
+		KeyID:          aws.StringValue(resp.KeyId),
+	}
+
+	dkCache.put(cacheKey, dk)
+
+	return dk, nil
+}
+
+// describeKeyArn resolve a key id or alias to the ARN of the key
+func describeKeyArn(keyID string) (string, error) {
+	resp, err := kmsSvc.DescribeKey(&kms.DescribeKeyInput{
+		KeyId: aws.String(keyID),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(resp.KeyMetadata.Arn), nil
+}
+
+// isThrottlingError returns true if kms rejected the request due to rate limiting
+func isThrottlingError(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == "ThrottlingException"
+	}
+	return false
+}
+
+type dataKeyCacheEntry struct {
+	key     string
+	dataKey *DataKey
+	expires time.Time
+}
+
+// dataKeyCache least recently used cache of decrypted data keys, a nil cache is
+// valid and never stores anything
+type dataKeyCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	ll         *list.List
+	entries    map[string]*list.Element
+}
+
+func newDataKeyCache(ttl time.Duration, maxEntries int) *dataKeyCache {
+	return &dataKeyCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		entries:    make(map[string]*list.Element),
+	}
+}
+
+func (c *dataKeyCache) get(key string) (*DataKey, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := el.Value.(*dataKeyCacheEntry)
+
+	if time.Now().After(entry.expires) {
+		c.remove(el)
+		return nil, false
+	}
+
+	c.ll.MoveToFront(el)
+
+	return entry.dataKey, true
+}
+
+func (c *dataKeyCache) put(key string, dk *DataKey) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expires := time.Now().Add(c.ttl)
+
+	if el, ok := c.entries[key]; ok {
+		entry := el.Value.(*dataKeyCacheEntry)
+		entry.dataKey = dk
+		entry.expires = expires
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	c.entries[key] = c.ll.PushFront(&dataKeyCacheEntry{key: key, dataKey: dk, expires: expires})
+
+	for c.ll.Len() > c.maxEntries {
+		c.remove(c.ll.Back())
+	}
+}
+
+func (c *dataKeyCache) remove(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.entries, el.Value.(*dataKeyCacheEntry).key)
+}
+
+// dataKeyCacheKey hash the wrapped key along with the sorted encryption context
+func dataKeyCacheKey(ciphertext []byte, encContext *EncryptionContextValue) string {
+	h := sha256.New()
+	h.Write(ciphertext)
+
+	if encContext != nil {
+		keys := make([]string, 0, len(*encContext))
+		for k := range *encContext {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			h.Write([]byte{0})
+			h.Write([]byte(k))
+			h.Write([]byte{0})
+			h.Write([]byte(aws.StringValue((*encContext)[k])))
+		}
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
 }
@@ -0,0 +1,88 @@
+package unicreds
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDecryptDataKeyCached(t *testing.T) {
+
+	_, kmsMock := configureMock()
+
+	ki := &kms.DecryptOutput{Plaintext: dsPlainText}
+
+	kmsMock.On("Decrypt", mock.AnythingOfType("*kms.DecryptInput")).Return(ki, nil)
+
+	SetDataKeyCache(time.Minute, 2)
+	defer SetDataKeyCache(0, 0)
+
+	encContext := NewEncryptionContextValue()
+	encContext.Set("stack:123")
+
+	for i := 0; i < 3; i++ {
+		dk, err := DecryptDataKey([]byte("wrapped"), encContext)
+		assert.Nil(t, err)
+		assert.Equal(t, dsPlainText, dk.Plaintext)
+	}
+
+	kmsMock.AssertNumberOfCalls(t, "Decrypt", 1)
+
+	// a different context must not be served from the cache
+	otherContext := NewEncryptionContextValue()
+	otherContext.Set("stack:456")
+
+	_, err := DecryptDataKey([]byte("wrapped"), otherContext)
+	assert.Nil(t, err)
+
+	kmsMock.AssertNumberOfCalls(t, "Decrypt", 2)
+}
+
+func TestDataKeyCacheEviction(t *testing.T) {
+
+	c := newDataKeyCache(time.Minute, 2)
+
+	c.put("a", &DataKey{})
+	c.put("b", &DataKey{})
+
+	// touch a so b is the least recently used
+	_, ok := c.get("a")
+	assert.True(t, ok)
+
+	c.put("c", &DataKey{})
+
+	_, ok = c.get("b")
+	assert.False(t, ok)
+
+	_, ok = c.get("a")
+	assert.True(t, ok)
+
+	_, ok = c.get("c")
+	assert.True(t, ok)
+}
+
+func TestDataKeyCacheExpiry(t *testing.T) {
+
+	c := newDataKeyCache(10*time.Millisecond, 2)
+
+	c.put("a", &DataKey{})
+
+	time.Sleep(20 * time.Millisecond)
+
+	_, ok := c.get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, c.ll.Len())
+}
+
+func TestDataKeyCacheDisabled(t *testing.T) {
+
+	var c *dataKeyCache
+
+	c.put("a", &DataKey{})
+
+	_, ok := c.get("a")
+	assert.False(t, ok)
+}
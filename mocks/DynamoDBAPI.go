@@ -12,6 +12,84 @@ type DynamoDBAPI struct {
 	mock.Mock
 }
 
+// BatchExecuteStatement provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) BatchExecuteStatement(_a0 *dynamodb.BatchExecuteStatementInput) (*dynamodb.BatchExecuteStatementOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.BatchExecuteStatementOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.BatchExecuteStatementInput) *dynamodb.BatchExecuteStatementOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.BatchExecuteStatementOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.BatchExecuteStatementInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchExecuteStatementRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) BatchExecuteStatementRequest(_a0 *dynamodb.BatchExecuteStatementInput) (*request.Request, *dynamodb.BatchExecuteStatementOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.BatchExecuteStatementInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.BatchExecuteStatementOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.BatchExecuteStatementInput) *dynamodb.BatchExecuteStatementOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.BatchExecuteStatementOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// BatchExecuteStatementWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) BatchExecuteStatementWithContext(_a0 aws.Context, _a1 *dynamodb.BatchExecuteStatementInput, _a2 ...request.Option) (*dynamodb.BatchExecuteStatementOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.BatchExecuteStatementOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.BatchExecuteStatementInput, ...request.Option) *dynamodb.BatchExecuteStatementOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.BatchExecuteStatementOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.BatchExecuteStatementInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BatchGetItem provides a mock function with given fields: _a0
 func (_m *DynamoDBAPI) BatchGetItem(_a0 *dynamodb.BatchGetItemInput) (*dynamodb.BatchGetItemOutput, error) {
 	ret := _m.Called(_a0)
@@ -593,6 +671,84 @@ func (_m *DynamoDBAPI) DeleteItemWithContext(_a0 aws.Context, _a1 *dynamodb.Dele
 	return r0, r1
 }
 
+// DeleteResourcePolicy provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DeleteResourcePolicy(_a0 *dynamodb.DeleteResourcePolicyInput) (*dynamodb.DeleteResourcePolicyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.DeleteResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DeleteResourcePolicyInput) *dynamodb.DeleteResourcePolicyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DeleteResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.DeleteResourcePolicyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteResourcePolicyRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DeleteResourcePolicyRequest(_a0 *dynamodb.DeleteResourcePolicyInput) (*request.Request, *dynamodb.DeleteResourcePolicyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.DeleteResourcePolicyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.DeleteResourcePolicyOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DeleteResourcePolicyInput) *dynamodb.DeleteResourcePolicyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.DeleteResourcePolicyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteResourcePolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DeleteResourcePolicyWithContext(_a0 aws.Context, _a1 *dynamodb.DeleteResourcePolicyInput, _a2 ...request.Option) (*dynamodb.DeleteResourcePolicyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.DeleteResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DeleteResourcePolicyInput, ...request.Option) *dynamodb.DeleteResourcePolicyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DeleteResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DeleteResourcePolicyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteTable provides a mock function with given fields: _a0
 func (_m *DynamoDBAPI) DeleteTable(_a0 *dynamodb.DeleteTableInput) (*dynamodb.DeleteTableOutput, error) {
 	ret := _m.Called(_a0)
@@ -827,21 +983,21 @@ func (_m *DynamoDBAPI) DescribeContinuousBackupsWithContext(_a0 aws.Context, _a1
 	return r0, r1
 }
 
-// DescribeGlobalTable provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) DescribeGlobalTable(_a0 *dynamodb.DescribeGlobalTableInput) (*dynamodb.DescribeGlobalTableOutput, error) {
+// DescribeContributorInsights provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeContributorInsights(_a0 *dynamodb.DescribeContributorInsightsInput) (*dynamodb.DescribeContributorInsightsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.DescribeGlobalTableOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeGlobalTableInput) *dynamodb.DescribeGlobalTableOutput); ok {
+	var r0 *dynamodb.DescribeContributorInsightsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeContributorInsightsInput) *dynamodb.DescribeContributorInsightsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.DescribeGlobalTableOutput)
+			r0 = ret.Get(0).(*dynamodb.DescribeContributorInsightsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeGlobalTableInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeContributorInsightsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -850,12 +1006,12 @@ func (_m *DynamoDBAPI) DescribeGlobalTable(_a0 *dynamodb.DescribeGlobalTableInpu
 	return r0, r1
 }
 
-// DescribeGlobalTableRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) DescribeGlobalTableRequest(_a0 *dynamodb.DescribeGlobalTableInput) (*request.Request, *dynamodb.DescribeGlobalTableOutput) {
+// DescribeContributorInsightsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeContributorInsightsRequest(_a0 *dynamodb.DescribeContributorInsightsInput) (*request.Request, *dynamodb.DescribeContributorInsightsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeGlobalTableInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeContributorInsightsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -863,20 +1019,20 @@ func (_m *DynamoDBAPI) DescribeGlobalTableRequest(_a0 *dynamodb.DescribeGlobalTa
 		}
 	}
 
-	var r1 *dynamodb.DescribeGlobalTableOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeGlobalTableInput) *dynamodb.DescribeGlobalTableOutput); ok {
+	var r1 *dynamodb.DescribeContributorInsightsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeContributorInsightsInput) *dynamodb.DescribeContributorInsightsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.DescribeGlobalTableOutput)
+			r1 = ret.Get(1).(*dynamodb.DescribeContributorInsightsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeGlobalTableWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) DescribeGlobalTableWithContext(_a0 aws.Context, _a1 *dynamodb.DescribeGlobalTableInput, _a2 ...request.Option) (*dynamodb.DescribeGlobalTableOutput, error) {
+// DescribeContributorInsightsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeContributorInsightsWithContext(_a0 aws.Context, _a1 *dynamodb.DescribeContributorInsightsInput, _a2 ...request.Option) (*dynamodb.DescribeContributorInsightsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -886,17 +1042,17 @@ func (_m *DynamoDBAPI) DescribeGlobalTableWithContext(_a0 aws.Context, _a1 *dyna
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.DescribeGlobalTableOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DescribeGlobalTableInput, ...request.Option) *dynamodb.DescribeGlobalTableOutput); ok {
+	var r0 *dynamodb.DescribeContributorInsightsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DescribeContributorInsightsInput, ...request.Option) *dynamodb.DescribeContributorInsightsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.DescribeGlobalTableOutput)
+			r0 = ret.Get(0).(*dynamodb.DescribeContributorInsightsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DescribeGlobalTableInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DescribeContributorInsightsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -905,21 +1061,21 @@ func (_m *DynamoDBAPI) DescribeGlobalTableWithContext(_a0 aws.Context, _a1 *dyna
 	return r0, r1
 }
 
-// DescribeLimits provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) DescribeLimits(_a0 *dynamodb.DescribeLimitsInput) (*dynamodb.DescribeLimitsOutput, error) {
+// DescribeEndpoints provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeEndpoints(_a0 *dynamodb.DescribeEndpointsInput) (*dynamodb.DescribeEndpointsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.DescribeLimitsOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeLimitsInput) *dynamodb.DescribeLimitsOutput); ok {
+	var r0 *dynamodb.DescribeEndpointsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeEndpointsInput) *dynamodb.DescribeEndpointsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.DescribeLimitsOutput)
+			r0 = ret.Get(0).(*dynamodb.DescribeEndpointsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeLimitsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeEndpointsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -928,12 +1084,12 @@ func (_m *DynamoDBAPI) DescribeLimits(_a0 *dynamodb.DescribeLimitsInput) (*dynam
 	return r0, r1
 }
 
-// DescribeLimitsRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) DescribeLimitsRequest(_a0 *dynamodb.DescribeLimitsInput) (*request.Request, *dynamodb.DescribeLimitsOutput) {
+// DescribeEndpointsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeEndpointsRequest(_a0 *dynamodb.DescribeEndpointsInput) (*request.Request, *dynamodb.DescribeEndpointsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeLimitsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeEndpointsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -941,20 +1097,20 @@ func (_m *DynamoDBAPI) DescribeLimitsRequest(_a0 *dynamodb.DescribeLimitsInput)
 		}
 	}
 
-	var r1 *dynamodb.DescribeLimitsOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeLimitsInput) *dynamodb.DescribeLimitsOutput); ok {
+	var r1 *dynamodb.DescribeEndpointsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeEndpointsInput) *dynamodb.DescribeEndpointsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.DescribeLimitsOutput)
+			r1 = ret.Get(1).(*dynamodb.DescribeEndpointsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeLimitsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) DescribeLimitsWithContext(_a0 aws.Context, _a1 *dynamodb.DescribeLimitsInput, _a2 ...request.Option) (*dynamodb.DescribeLimitsOutput, error) {
+// DescribeEndpointsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeEndpointsWithContext(_a0 aws.Context, _a1 *dynamodb.DescribeEndpointsInput, _a2 ...request.Option) (*dynamodb.DescribeEndpointsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -964,17 +1120,17 @@ func (_m *DynamoDBAPI) DescribeLimitsWithContext(_a0 aws.Context, _a1 *dynamodb.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.DescribeLimitsOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DescribeLimitsInput, ...request.Option) *dynamodb.DescribeLimitsOutput); ok {
+	var r0 *dynamodb.DescribeEndpointsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DescribeEndpointsInput, ...request.Option) *dynamodb.DescribeEndpointsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.DescribeLimitsOutput)
+			r0 = ret.Get(0).(*dynamodb.DescribeEndpointsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DescribeLimitsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DescribeEndpointsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -983,21 +1139,21 @@ func (_m *DynamoDBAPI) DescribeLimitsWithContext(_a0 aws.Context, _a1 *dynamodb.
 	return r0, r1
 }
 
-// DescribeTable provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) DescribeTable(_a0 *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
+// DescribeExport provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeExport(_a0 *dynamodb.DescribeExportInput) (*dynamodb.DescribeExportOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.DescribeTableOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTableInput) *dynamodb.DescribeTableOutput); ok {
+	var r0 *dynamodb.DescribeExportOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeExportInput) *dynamodb.DescribeExportOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.DescribeTableOutput)
+			r0 = ret.Get(0).(*dynamodb.DescribeExportOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTableInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeExportInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1006,12 +1162,12 @@ func (_m *DynamoDBAPI) DescribeTable(_a0 *dynamodb.DescribeTableInput) (*dynamod
 	return r0, r1
 }
 
-// DescribeTableRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) DescribeTableRequest(_a0 *dynamodb.DescribeTableInput) (*request.Request, *dynamodb.DescribeTableOutput) {
+// DescribeExportRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeExportRequest(_a0 *dynamodb.DescribeExportInput) (*request.Request, *dynamodb.DescribeExportOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTableInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeExportInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1019,20 +1175,20 @@ func (_m *DynamoDBAPI) DescribeTableRequest(_a0 *dynamodb.DescribeTableInput) (*
 		}
 	}
 
-	var r1 *dynamodb.DescribeTableOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTableInput) *dynamodb.DescribeTableOutput); ok {
+	var r1 *dynamodb.DescribeExportOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeExportInput) *dynamodb.DescribeExportOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.DescribeTableOutput)
+			r1 = ret.Get(1).(*dynamodb.DescribeExportOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeTableWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) DescribeTableWithContext(_a0 aws.Context, _a1 *dynamodb.DescribeTableInput, _a2 ...request.Option) (*dynamodb.DescribeTableOutput, error) {
+// DescribeExportWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeExportWithContext(_a0 aws.Context, _a1 *dynamodb.DescribeExportInput, _a2 ...request.Option) (*dynamodb.DescribeExportOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1042,17 +1198,17 @@ func (_m *DynamoDBAPI) DescribeTableWithContext(_a0 aws.Context, _a1 *dynamodb.D
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.DescribeTableOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DescribeTableInput, ...request.Option) *dynamodb.DescribeTableOutput); ok {
+	var r0 *dynamodb.DescribeExportOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DescribeExportInput, ...request.Option) *dynamodb.DescribeExportOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.DescribeTableOutput)
+			r0 = ret.Get(0).(*dynamodb.DescribeExportOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DescribeTableInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DescribeExportInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1061,21 +1217,21 @@ func (_m *DynamoDBAPI) DescribeTableWithContext(_a0 aws.Context, _a1 *dynamodb.D
 	return r0, r1
 }
 
-// DescribeTimeToLive provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) DescribeTimeToLive(_a0 *dynamodb.DescribeTimeToLiveInput) (*dynamodb.DescribeTimeToLiveOutput, error) {
+// DescribeGlobalTable provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeGlobalTable(_a0 *dynamodb.DescribeGlobalTableInput) (*dynamodb.DescribeGlobalTableOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.DescribeTimeToLiveOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTimeToLiveInput) *dynamodb.DescribeTimeToLiveOutput); ok {
+	var r0 *dynamodb.DescribeGlobalTableOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeGlobalTableInput) *dynamodb.DescribeGlobalTableOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.DescribeTimeToLiveOutput)
+			r0 = ret.Get(0).(*dynamodb.DescribeGlobalTableOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTimeToLiveInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeGlobalTableInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1084,12 +1240,12 @@ func (_m *DynamoDBAPI) DescribeTimeToLive(_a0 *dynamodb.DescribeTimeToLiveInput)
 	return r0, r1
 }
 
-// DescribeTimeToLiveRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) DescribeTimeToLiveRequest(_a0 *dynamodb.DescribeTimeToLiveInput) (*request.Request, *dynamodb.DescribeTimeToLiveOutput) {
+// DescribeGlobalTableRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeGlobalTableRequest(_a0 *dynamodb.DescribeGlobalTableInput) (*request.Request, *dynamodb.DescribeGlobalTableOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTimeToLiveInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeGlobalTableInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1097,63 +1253,33 @@ func (_m *DynamoDBAPI) DescribeTimeToLiveRequest(_a0 *dynamodb.DescribeTimeToLiv
 		}
 	}
 
-	var r1 *dynamodb.DescribeTimeToLiveOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTimeToLiveInput) *dynamodb.DescribeTimeToLiveOutput); ok {
+	var r1 *dynamodb.DescribeGlobalTableOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeGlobalTableInput) *dynamodb.DescribeGlobalTableOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.DescribeTimeToLiveOutput)
-		}
-	}
-
-	return r0, r1
-}
-
-// DescribeTimeToLiveWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) DescribeTimeToLiveWithContext(_a0 aws.Context, _a1 *dynamodb.DescribeTimeToLiveInput, _a2 ...request.Option) (*dynamodb.DescribeTimeToLiveOutput, error) {
-	_va := make([]interface{}, len(_a2))
-	for _i := range _a2 {
-		_va[_i] = _a2[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 *dynamodb.DescribeTimeToLiveOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DescribeTimeToLiveInput, ...request.Option) *dynamodb.DescribeTimeToLiveOutput); ok {
-		r0 = rf(_a0, _a1, _a2...)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.DescribeTimeToLiveOutput)
+			r1 = ret.Get(1).(*dynamodb.DescribeGlobalTableOutput)
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DescribeTimeToLiveInput, ...request.Option) error); ok {
-		r1 = rf(_a0, _a1, _a2...)
-	} else {
-		r1 = ret.Error(1)
-	}
-
 	return r0, r1
 }
 
-// GetItem provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) GetItem(_a0 *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+// DescribeGlobalTableSettings provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeGlobalTableSettings(_a0 *dynamodb.DescribeGlobalTableSettingsInput) (*dynamodb.DescribeGlobalTableSettingsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.GetItemOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.GetItemInput) *dynamodb.GetItemOutput); ok {
+	var r0 *dynamodb.DescribeGlobalTableSettingsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeGlobalTableSettingsInput) *dynamodb.DescribeGlobalTableSettingsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.GetItemOutput)
+			r0 = ret.Get(0).(*dynamodb.DescribeGlobalTableSettingsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.GetItemInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeGlobalTableSettingsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1162,12 +1288,12 @@ func (_m *DynamoDBAPI) GetItem(_a0 *dynamodb.GetItemInput) (*dynamodb.GetItemOut
 	return r0, r1
 }
 
-// GetItemRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) GetItemRequest(_a0 *dynamodb.GetItemInput) (*request.Request, *dynamodb.GetItemOutput) {
+// DescribeGlobalTableSettingsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeGlobalTableSettingsRequest(_a0 *dynamodb.DescribeGlobalTableSettingsInput) (*request.Request, *dynamodb.DescribeGlobalTableSettingsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.GetItemInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeGlobalTableSettingsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1175,20 +1301,20 @@ func (_m *DynamoDBAPI) GetItemRequest(_a0 *dynamodb.GetItemInput) (*request.Requ
 		}
 	}
 
-	var r1 *dynamodb.GetItemOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.GetItemInput) *dynamodb.GetItemOutput); ok {
+	var r1 *dynamodb.DescribeGlobalTableSettingsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeGlobalTableSettingsInput) *dynamodb.DescribeGlobalTableSettingsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.GetItemOutput)
+			r1 = ret.Get(1).(*dynamodb.DescribeGlobalTableSettingsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// GetItemWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) GetItemWithContext(_a0 aws.Context, _a1 *dynamodb.GetItemInput, _a2 ...request.Option) (*dynamodb.GetItemOutput, error) {
+// DescribeGlobalTableSettingsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeGlobalTableSettingsWithContext(_a0 aws.Context, _a1 *dynamodb.DescribeGlobalTableSettingsInput, _a2 ...request.Option) (*dynamodb.DescribeGlobalTableSettingsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1198,17 +1324,17 @@ func (_m *DynamoDBAPI) GetItemWithContext(_a0 aws.Context, _a1 *dynamodb.GetItem
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.GetItemOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.GetItemInput, ...request.Option) *dynamodb.GetItemOutput); ok {
+	var r0 *dynamodb.DescribeGlobalTableSettingsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DescribeGlobalTableSettingsInput, ...request.Option) *dynamodb.DescribeGlobalTableSettingsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.GetItemOutput)
+			r0 = ret.Get(0).(*dynamodb.DescribeGlobalTableSettingsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.GetItemInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DescribeGlobalTableSettingsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1217,21 +1343,51 @@ func (_m *DynamoDBAPI) GetItemWithContext(_a0 aws.Context, _a1 *dynamodb.GetItem
 	return r0, r1
 }
 
-// ListBackups provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) ListBackups(_a0 *dynamodb.ListBackupsInput) (*dynamodb.ListBackupsOutput, error) {
-	ret := _m.Called(_a0)
-
-	var r0 *dynamodb.ListBackupsOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.ListBackupsInput) *dynamodb.ListBackupsOutput); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.ListBackupsOutput)
+// DescribeGlobalTableWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeGlobalTableWithContext(_a0 aws.Context, _a1 *dynamodb.DescribeGlobalTableInput, _a2 ...request.Option) (*dynamodb.DescribeGlobalTableOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.DescribeGlobalTableOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DescribeGlobalTableInput, ...request.Option) *dynamodb.DescribeGlobalTableOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeGlobalTableOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.ListBackupsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DescribeGlobalTableInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeImport provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeImport(_a0 *dynamodb.DescribeImportInput) (*dynamodb.DescribeImportOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.DescribeImportOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeImportInput) *dynamodb.DescribeImportOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeImportOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeImportInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1240,12 +1396,12 @@ func (_m *DynamoDBAPI) ListBackups(_a0 *dynamodb.ListBackupsInput) (*dynamodb.Li
 	return r0, r1
 }
 
-// ListBackupsRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) ListBackupsRequest(_a0 *dynamodb.ListBackupsInput) (*request.Request, *dynamodb.ListBackupsOutput) {
+// DescribeImportRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeImportRequest(_a0 *dynamodb.DescribeImportInput) (*request.Request, *dynamodb.DescribeImportOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.ListBackupsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeImportInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1253,20 +1409,20 @@ func (_m *DynamoDBAPI) ListBackupsRequest(_a0 *dynamodb.ListBackupsInput) (*requ
 		}
 	}
 
-	var r1 *dynamodb.ListBackupsOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.ListBackupsInput) *dynamodb.ListBackupsOutput); ok {
+	var r1 *dynamodb.DescribeImportOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeImportInput) *dynamodb.DescribeImportOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.ListBackupsOutput)
+			r1 = ret.Get(1).(*dynamodb.DescribeImportOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListBackupsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) ListBackupsWithContext(_a0 aws.Context, _a1 *dynamodb.ListBackupsInput, _a2 ...request.Option) (*dynamodb.ListBackupsOutput, error) {
+// DescribeImportWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeImportWithContext(_a0 aws.Context, _a1 *dynamodb.DescribeImportInput, _a2 ...request.Option) (*dynamodb.DescribeImportOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1276,17 +1432,17 @@ func (_m *DynamoDBAPI) ListBackupsWithContext(_a0 aws.Context, _a1 *dynamodb.Lis
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.ListBackupsOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListBackupsInput, ...request.Option) *dynamodb.ListBackupsOutput); ok {
+	var r0 *dynamodb.DescribeImportOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DescribeImportInput, ...request.Option) *dynamodb.DescribeImportOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.ListBackupsOutput)
+			r0 = ret.Get(0).(*dynamodb.DescribeImportOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ListBackupsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DescribeImportInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1295,21 +1451,21 @@ func (_m *DynamoDBAPI) ListBackupsWithContext(_a0 aws.Context, _a1 *dynamodb.Lis
 	return r0, r1
 }
 
-// ListGlobalTables provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) ListGlobalTables(_a0 *dynamodb.ListGlobalTablesInput) (*dynamodb.ListGlobalTablesOutput, error) {
+// DescribeKinesisStreamingDestination provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeKinesisStreamingDestination(_a0 *dynamodb.DescribeKinesisStreamingDestinationInput) (*dynamodb.DescribeKinesisStreamingDestinationOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.ListGlobalTablesOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.ListGlobalTablesInput) *dynamodb.ListGlobalTablesOutput); ok {
+	var r0 *dynamodb.DescribeKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeKinesisStreamingDestinationInput) *dynamodb.DescribeKinesisStreamingDestinationOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.ListGlobalTablesOutput)
+			r0 = ret.Get(0).(*dynamodb.DescribeKinesisStreamingDestinationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.ListGlobalTablesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeKinesisStreamingDestinationInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1318,12 +1474,12 @@ func (_m *DynamoDBAPI) ListGlobalTables(_a0 *dynamodb.ListGlobalTablesInput) (*d
 	return r0, r1
 }
 
-// ListGlobalTablesRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) ListGlobalTablesRequest(_a0 *dynamodb.ListGlobalTablesInput) (*request.Request, *dynamodb.ListGlobalTablesOutput) {
+// DescribeKinesisStreamingDestinationRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeKinesisStreamingDestinationRequest(_a0 *dynamodb.DescribeKinesisStreamingDestinationInput) (*request.Request, *dynamodb.DescribeKinesisStreamingDestinationOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.ListGlobalTablesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeKinesisStreamingDestinationInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1331,20 +1487,20 @@ func (_m *DynamoDBAPI) ListGlobalTablesRequest(_a0 *dynamodb.ListGlobalTablesInp
 		}
 	}
 
-	var r1 *dynamodb.ListGlobalTablesOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.ListGlobalTablesInput) *dynamodb.ListGlobalTablesOutput); ok {
+	var r1 *dynamodb.DescribeKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeKinesisStreamingDestinationInput) *dynamodb.DescribeKinesisStreamingDestinationOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.ListGlobalTablesOutput)
+			r1 = ret.Get(1).(*dynamodb.DescribeKinesisStreamingDestinationOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListGlobalTablesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) ListGlobalTablesWithContext(_a0 aws.Context, _a1 *dynamodb.ListGlobalTablesInput, _a2 ...request.Option) (*dynamodb.ListGlobalTablesOutput, error) {
+// DescribeKinesisStreamingDestinationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeKinesisStreamingDestinationWithContext(_a0 aws.Context, _a1 *dynamodb.DescribeKinesisStreamingDestinationInput, _a2 ...request.Option) (*dynamodb.DescribeKinesisStreamingDestinationOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1354,17 +1510,17 @@ func (_m *DynamoDBAPI) ListGlobalTablesWithContext(_a0 aws.Context, _a1 *dynamod
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.ListGlobalTablesOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListGlobalTablesInput, ...request.Option) *dynamodb.ListGlobalTablesOutput); ok {
+	var r0 *dynamodb.DescribeKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DescribeKinesisStreamingDestinationInput, ...request.Option) *dynamodb.DescribeKinesisStreamingDestinationOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.ListGlobalTablesOutput)
+			r0 = ret.Get(0).(*dynamodb.DescribeKinesisStreamingDestinationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ListGlobalTablesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DescribeKinesisStreamingDestinationInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1373,21 +1529,21 @@ func (_m *DynamoDBAPI) ListGlobalTablesWithContext(_a0 aws.Context, _a1 *dynamod
 	return r0, r1
 }
 
-// ListTables provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) ListTables(_a0 *dynamodb.ListTablesInput) (*dynamodb.ListTablesOutput, error) {
+// DescribeLimits provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeLimits(_a0 *dynamodb.DescribeLimitsInput) (*dynamodb.DescribeLimitsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.ListTablesOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.ListTablesInput) *dynamodb.ListTablesOutput); ok {
+	var r0 *dynamodb.DescribeLimitsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeLimitsInput) *dynamodb.DescribeLimitsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.ListTablesOutput)
+			r0 = ret.Get(0).(*dynamodb.DescribeLimitsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.ListTablesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeLimitsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1396,47 +1552,12 @@ func (_m *DynamoDBAPI) ListTables(_a0 *dynamodb.ListTablesInput) (*dynamodb.List
 	return r0, r1
 }
 
-// ListTablesPages provides a mock function with given fields: _a0, _a1
-func (_m *DynamoDBAPI) ListTablesPages(_a0 *dynamodb.ListTablesInput, _a1 func(*dynamodb.ListTablesOutput, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*dynamodb.ListTablesInput, func(*dynamodb.ListTablesOutput, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// ListTablesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *DynamoDBAPI) ListTablesPagesWithContext(_a0 aws.Context, _a1 *dynamodb.ListTablesInput, _a2 func(*dynamodb.ListTablesOutput, bool) bool, _a3 ...request.Option) error {
-	_va := make([]interface{}, len(_a3))
-	for _i := range _a3 {
-		_va[_i] = _a3[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1, _a2)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListTablesInput, func(*dynamodb.ListTablesOutput, bool) bool, ...request.Option) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3...)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// ListTablesRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) ListTablesRequest(_a0 *dynamodb.ListTablesInput) (*request.Request, *dynamodb.ListTablesOutput) {
+// DescribeLimitsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeLimitsRequest(_a0 *dynamodb.DescribeLimitsInput) (*request.Request, *dynamodb.DescribeLimitsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.ListTablesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeLimitsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1444,20 +1565,20 @@ func (_m *DynamoDBAPI) ListTablesRequest(_a0 *dynamodb.ListTablesInput) (*reques
 		}
 	}
 
-	var r1 *dynamodb.ListTablesOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.ListTablesInput) *dynamodb.ListTablesOutput); ok {
+	var r1 *dynamodb.DescribeLimitsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeLimitsInput) *dynamodb.DescribeLimitsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.ListTablesOutput)
+			r1 = ret.Get(1).(*dynamodb.DescribeLimitsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListTablesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) ListTablesWithContext(_a0 aws.Context, _a1 *dynamodb.ListTablesInput, _a2 ...request.Option) (*dynamodb.ListTablesOutput, error) {
+// DescribeLimitsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeLimitsWithContext(_a0 aws.Context, _a1 *dynamodb.DescribeLimitsInput, _a2 ...request.Option) (*dynamodb.DescribeLimitsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1467,17 +1588,17 @@ func (_m *DynamoDBAPI) ListTablesWithContext(_a0 aws.Context, _a1 *dynamodb.List
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.ListTablesOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListTablesInput, ...request.Option) *dynamodb.ListTablesOutput); ok {
+	var r0 *dynamodb.DescribeLimitsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DescribeLimitsInput, ...request.Option) *dynamodb.DescribeLimitsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.ListTablesOutput)
+			r0 = ret.Get(0).(*dynamodb.DescribeLimitsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ListTablesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DescribeLimitsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1486,21 +1607,21 @@ func (_m *DynamoDBAPI) ListTablesWithContext(_a0 aws.Context, _a1 *dynamodb.List
 	return r0, r1
 }
 
-// ListTagsOfResource provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) ListTagsOfResource(_a0 *dynamodb.ListTagsOfResourceInput) (*dynamodb.ListTagsOfResourceOutput, error) {
+// DescribeTable provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeTable(_a0 *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.ListTagsOfResourceOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.ListTagsOfResourceInput) *dynamodb.ListTagsOfResourceOutput); ok {
+	var r0 *dynamodb.DescribeTableOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTableInput) *dynamodb.DescribeTableOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.ListTagsOfResourceOutput)
+			r0 = ret.Get(0).(*dynamodb.DescribeTableOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.ListTagsOfResourceInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTableInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1509,12 +1630,35 @@ func (_m *DynamoDBAPI) ListTagsOfResource(_a0 *dynamodb.ListTagsOfResourceInput)
 	return r0, r1
 }
 
-// ListTagsOfResourceRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) ListTagsOfResourceRequest(_a0 *dynamodb.ListTagsOfResourceInput) (*request.Request, *dynamodb.ListTagsOfResourceOutput) {
+// DescribeTableReplicaAutoScaling provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeTableReplicaAutoScaling(_a0 *dynamodb.DescribeTableReplicaAutoScalingInput) (*dynamodb.DescribeTableReplicaAutoScalingOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.DescribeTableReplicaAutoScalingOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTableReplicaAutoScalingInput) *dynamodb.DescribeTableReplicaAutoScalingOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeTableReplicaAutoScalingOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTableReplicaAutoScalingInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTableReplicaAutoScalingRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeTableReplicaAutoScalingRequest(_a0 *dynamodb.DescribeTableReplicaAutoScalingInput) (*request.Request, *dynamodb.DescribeTableReplicaAutoScalingOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.ListTagsOfResourceInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTableReplicaAutoScalingInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1522,20 +1666,20 @@ func (_m *DynamoDBAPI) ListTagsOfResourceRequest(_a0 *dynamodb.ListTagsOfResourc
 		}
 	}
 
-	var r1 *dynamodb.ListTagsOfResourceOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.ListTagsOfResourceInput) *dynamodb.ListTagsOfResourceOutput); ok {
+	var r1 *dynamodb.DescribeTableReplicaAutoScalingOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTableReplicaAutoScalingInput) *dynamodb.DescribeTableReplicaAutoScalingOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.ListTagsOfResourceOutput)
+			r1 = ret.Get(1).(*dynamodb.DescribeTableReplicaAutoScalingOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListTagsOfResourceWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) ListTagsOfResourceWithContext(_a0 aws.Context, _a1 *dynamodb.ListTagsOfResourceInput, _a2 ...request.Option) (*dynamodb.ListTagsOfResourceOutput, error) {
+// DescribeTableReplicaAutoScalingWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeTableReplicaAutoScalingWithContext(_a0 aws.Context, _a1 *dynamodb.DescribeTableReplicaAutoScalingInput, _a2 ...request.Option) (*dynamodb.DescribeTableReplicaAutoScalingOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1545,17 +1689,17 @@ func (_m *DynamoDBAPI) ListTagsOfResourceWithContext(_a0 aws.Context, _a1 *dynam
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.ListTagsOfResourceOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListTagsOfResourceInput, ...request.Option) *dynamodb.ListTagsOfResourceOutput); ok {
+	var r0 *dynamodb.DescribeTableReplicaAutoScalingOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DescribeTableReplicaAutoScalingInput, ...request.Option) *dynamodb.DescribeTableReplicaAutoScalingOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.ListTagsOfResourceOutput)
+			r0 = ret.Get(0).(*dynamodb.DescribeTableReplicaAutoScalingOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ListTagsOfResourceInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DescribeTableReplicaAutoScalingInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1564,35 +1708,12 @@ func (_m *DynamoDBAPI) ListTagsOfResourceWithContext(_a0 aws.Context, _a1 *dynam
 	return r0, r1
 }
 
-// PutItem provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) PutItem(_a0 *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
-	ret := _m.Called(_a0)
-
-	var r0 *dynamodb.PutItemOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.PutItemInput) *dynamodb.PutItemOutput); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.PutItemOutput)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.PutItemInput) error); ok {
-		r1 = rf(_a0)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// PutItemRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) PutItemRequest(_a0 *dynamodb.PutItemInput) (*request.Request, *dynamodb.PutItemOutput) {
+// DescribeTableRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeTableRequest(_a0 *dynamodb.DescribeTableInput) (*request.Request, *dynamodb.DescribeTableOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.PutItemInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTableInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1600,20 +1721,20 @@ func (_m *DynamoDBAPI) PutItemRequest(_a0 *dynamodb.PutItemInput) (*request.Requ
 		}
 	}
 
-	var r1 *dynamodb.PutItemOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.PutItemInput) *dynamodb.PutItemOutput); ok {
+	var r1 *dynamodb.DescribeTableOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTableInput) *dynamodb.DescribeTableOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.PutItemOutput)
+			r1 = ret.Get(1).(*dynamodb.DescribeTableOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// PutItemWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) PutItemWithContext(_a0 aws.Context, _a1 *dynamodb.PutItemInput, _a2 ...request.Option) (*dynamodb.PutItemOutput, error) {
+// DescribeTableWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeTableWithContext(_a0 aws.Context, _a1 *dynamodb.DescribeTableInput, _a2 ...request.Option) (*dynamodb.DescribeTableOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1623,17 +1744,17 @@ func (_m *DynamoDBAPI) PutItemWithContext(_a0 aws.Context, _a1 *dynamodb.PutItem
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.PutItemOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.PutItemInput, ...request.Option) *dynamodb.PutItemOutput); ok {
+	var r0 *dynamodb.DescribeTableOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DescribeTableInput, ...request.Option) *dynamodb.DescribeTableOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.PutItemOutput)
+			r0 = ret.Get(0).(*dynamodb.DescribeTableOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.PutItemInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DescribeTableInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1642,21 +1763,21 @@ func (_m *DynamoDBAPI) PutItemWithContext(_a0 aws.Context, _a1 *dynamodb.PutItem
 	return r0, r1
 }
 
-// Query provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) Query(_a0 *dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
+// DescribeTimeToLive provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeTimeToLive(_a0 *dynamodb.DescribeTimeToLiveInput) (*dynamodb.DescribeTimeToLiveOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.QueryOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.QueryInput) *dynamodb.QueryOutput); ok {
+	var r0 *dynamodb.DescribeTimeToLiveOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTimeToLiveInput) *dynamodb.DescribeTimeToLiveOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.QueryOutput)
+			r0 = ret.Get(0).(*dynamodb.DescribeTimeToLiveOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.QueryInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTimeToLiveInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1665,20 +1786,1564 @@ func (_m *DynamoDBAPI) Query(_a0 *dynamodb.QueryInput) (*dynamodb.QueryOutput, e
 	return r0, r1
 }
 
-// QueryPages provides a mock function with given fields: _a0, _a1
-func (_m *DynamoDBAPI) QueryPages(_a0 *dynamodb.QueryInput, _a1 func(*dynamodb.QueryOutput, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
+// DescribeTimeToLiveRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeTimeToLiveRequest(_a0 *dynamodb.DescribeTimeToLiveInput) (*request.Request, *dynamodb.DescribeTimeToLiveOutput) {
+	ret := _m.Called(_a0)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*dynamodb.QueryInput, func(*dynamodb.QueryOutput, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTimeToLiveInput) *request.Request); ok {
+		r0 = rf(_a0)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
 	}
 
-	return r0
-}
-
+	var r1 *dynamodb.DescribeTimeToLiveOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTimeToLiveInput) *dynamodb.DescribeTimeToLiveOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.DescribeTimeToLiveOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeTimeToLiveWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeTimeToLiveWithContext(_a0 aws.Context, _a1 *dynamodb.DescribeTimeToLiveInput, _a2 ...request.Option) (*dynamodb.DescribeTimeToLiveOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.DescribeTimeToLiveOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DescribeTimeToLiveInput, ...request.Option) *dynamodb.DescribeTimeToLiveOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeTimeToLiveOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DescribeTimeToLiveInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisableKinesisStreamingDestination provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DisableKinesisStreamingDestination(_a0 *dynamodb.DisableKinesisStreamingDestinationInput) (*dynamodb.DisableKinesisStreamingDestinationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.DisableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DisableKinesisStreamingDestinationInput) *dynamodb.DisableKinesisStreamingDestinationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DisableKinesisStreamingDestinationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.DisableKinesisStreamingDestinationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisableKinesisStreamingDestinationRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DisableKinesisStreamingDestinationRequest(_a0 *dynamodb.DisableKinesisStreamingDestinationInput) (*request.Request, *dynamodb.DisableKinesisStreamingDestinationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.DisableKinesisStreamingDestinationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.DisableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DisableKinesisStreamingDestinationInput) *dynamodb.DisableKinesisStreamingDestinationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.DisableKinesisStreamingDestinationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DisableKinesisStreamingDestinationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DisableKinesisStreamingDestinationWithContext(_a0 aws.Context, _a1 *dynamodb.DisableKinesisStreamingDestinationInput, _a2 ...request.Option) (*dynamodb.DisableKinesisStreamingDestinationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.DisableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DisableKinesisStreamingDestinationInput, ...request.Option) *dynamodb.DisableKinesisStreamingDestinationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DisableKinesisStreamingDestinationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DisableKinesisStreamingDestinationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnableKinesisStreamingDestination provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) EnableKinesisStreamingDestination(_a0 *dynamodb.EnableKinesisStreamingDestinationInput) (*dynamodb.EnableKinesisStreamingDestinationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.EnableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.EnableKinesisStreamingDestinationInput) *dynamodb.EnableKinesisStreamingDestinationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.EnableKinesisStreamingDestinationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.EnableKinesisStreamingDestinationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnableKinesisStreamingDestinationRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) EnableKinesisStreamingDestinationRequest(_a0 *dynamodb.EnableKinesisStreamingDestinationInput) (*request.Request, *dynamodb.EnableKinesisStreamingDestinationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.EnableKinesisStreamingDestinationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.EnableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.EnableKinesisStreamingDestinationInput) *dynamodb.EnableKinesisStreamingDestinationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.EnableKinesisStreamingDestinationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// EnableKinesisStreamingDestinationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) EnableKinesisStreamingDestinationWithContext(_a0 aws.Context, _a1 *dynamodb.EnableKinesisStreamingDestinationInput, _a2 ...request.Option) (*dynamodb.EnableKinesisStreamingDestinationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.EnableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.EnableKinesisStreamingDestinationInput, ...request.Option) *dynamodb.EnableKinesisStreamingDestinationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.EnableKinesisStreamingDestinationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.EnableKinesisStreamingDestinationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExecuteStatement provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ExecuteStatement(_a0 *dynamodb.ExecuteStatementInput) (*dynamodb.ExecuteStatementOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ExecuteStatementOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExecuteStatementInput) *dynamodb.ExecuteStatementOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ExecuteStatementOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExecuteStatementInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExecuteStatementRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ExecuteStatementRequest(_a0 *dynamodb.ExecuteStatementInput) (*request.Request, *dynamodb.ExecuteStatementOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExecuteStatementInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ExecuteStatementOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExecuteStatementInput) *dynamodb.ExecuteStatementOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ExecuteStatementOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExecuteStatementWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ExecuteStatementWithContext(_a0 aws.Context, _a1 *dynamodb.ExecuteStatementInput, _a2 ...request.Option) (*dynamodb.ExecuteStatementOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ExecuteStatementOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ExecuteStatementInput, ...request.Option) *dynamodb.ExecuteStatementOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ExecuteStatementOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ExecuteStatementInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExecuteTransaction provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ExecuteTransaction(_a0 *dynamodb.ExecuteTransactionInput) (*dynamodb.ExecuteTransactionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ExecuteTransactionOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExecuteTransactionInput) *dynamodb.ExecuteTransactionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ExecuteTransactionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExecuteTransactionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExecuteTransactionRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ExecuteTransactionRequest(_a0 *dynamodb.ExecuteTransactionInput) (*request.Request, *dynamodb.ExecuteTransactionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExecuteTransactionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ExecuteTransactionOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExecuteTransactionInput) *dynamodb.ExecuteTransactionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ExecuteTransactionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExecuteTransactionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ExecuteTransactionWithContext(_a0 aws.Context, _a1 *dynamodb.ExecuteTransactionInput, _a2 ...request.Option) (*dynamodb.ExecuteTransactionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ExecuteTransactionOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ExecuteTransactionInput, ...request.Option) *dynamodb.ExecuteTransactionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ExecuteTransactionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ExecuteTransactionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportTableToPointInTime provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ExportTableToPointInTime(_a0 *dynamodb.ExportTableToPointInTimeInput) (*dynamodb.ExportTableToPointInTimeOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ExportTableToPointInTimeOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExportTableToPointInTimeInput) *dynamodb.ExportTableToPointInTimeOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ExportTableToPointInTimeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExportTableToPointInTimeInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportTableToPointInTimeRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ExportTableToPointInTimeRequest(_a0 *dynamodb.ExportTableToPointInTimeInput) (*request.Request, *dynamodb.ExportTableToPointInTimeOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExportTableToPointInTimeInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ExportTableToPointInTimeOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExportTableToPointInTimeInput) *dynamodb.ExportTableToPointInTimeOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ExportTableToPointInTimeOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportTableToPointInTimeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ExportTableToPointInTimeWithContext(_a0 aws.Context, _a1 *dynamodb.ExportTableToPointInTimeInput, _a2 ...request.Option) (*dynamodb.ExportTableToPointInTimeOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ExportTableToPointInTimeOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ExportTableToPointInTimeInput, ...request.Option) *dynamodb.ExportTableToPointInTimeOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ExportTableToPointInTimeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ExportTableToPointInTimeInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetItem provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) GetItem(_a0 *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.GetItemOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.GetItemInput) *dynamodb.GetItemOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.GetItemOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.GetItemInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetItemRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) GetItemRequest(_a0 *dynamodb.GetItemInput) (*request.Request, *dynamodb.GetItemOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.GetItemInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.GetItemOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.GetItemInput) *dynamodb.GetItemOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.GetItemOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetItemWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) GetItemWithContext(_a0 aws.Context, _a1 *dynamodb.GetItemInput, _a2 ...request.Option) (*dynamodb.GetItemOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.GetItemOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.GetItemInput, ...request.Option) *dynamodb.GetItemOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.GetItemOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.GetItemInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetResourcePolicy provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) GetResourcePolicy(_a0 *dynamodb.GetResourcePolicyInput) (*dynamodb.GetResourcePolicyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.GetResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.GetResourcePolicyInput) *dynamodb.GetResourcePolicyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.GetResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.GetResourcePolicyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetResourcePolicyRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) GetResourcePolicyRequest(_a0 *dynamodb.GetResourcePolicyInput) (*request.Request, *dynamodb.GetResourcePolicyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.GetResourcePolicyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.GetResourcePolicyOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.GetResourcePolicyInput) *dynamodb.GetResourcePolicyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.GetResourcePolicyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetResourcePolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) GetResourcePolicyWithContext(_a0 aws.Context, _a1 *dynamodb.GetResourcePolicyInput, _a2 ...request.Option) (*dynamodb.GetResourcePolicyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.GetResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.GetResourcePolicyInput, ...request.Option) *dynamodb.GetResourcePolicyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.GetResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.GetResourcePolicyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportTable provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ImportTable(_a0 *dynamodb.ImportTableInput) (*dynamodb.ImportTableOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ImportTableOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ImportTableInput) *dynamodb.ImportTableOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ImportTableOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ImportTableInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportTableRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ImportTableRequest(_a0 *dynamodb.ImportTableInput) (*request.Request, *dynamodb.ImportTableOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ImportTableInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ImportTableOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ImportTableInput) *dynamodb.ImportTableOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ImportTableOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ImportTableWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ImportTableWithContext(_a0 aws.Context, _a1 *dynamodb.ImportTableInput, _a2 ...request.Option) (*dynamodb.ImportTableOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ImportTableOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ImportTableInput, ...request.Option) *dynamodb.ImportTableOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ImportTableOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ImportTableInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBackups provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListBackups(_a0 *dynamodb.ListBackupsInput) (*dynamodb.ListBackupsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ListBackupsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListBackupsInput) *dynamodb.ListBackupsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListBackupsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListBackupsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBackupsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListBackupsRequest(_a0 *dynamodb.ListBackupsInput) (*request.Request, *dynamodb.ListBackupsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListBackupsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ListBackupsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListBackupsInput) *dynamodb.ListBackupsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ListBackupsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListBackupsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ListBackupsWithContext(_a0 aws.Context, _a1 *dynamodb.ListBackupsInput, _a2 ...request.Option) (*dynamodb.ListBackupsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ListBackupsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListBackupsInput, ...request.Option) *dynamodb.ListBackupsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListBackupsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ListBackupsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListContributorInsights provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListContributorInsights(_a0 *dynamodb.ListContributorInsightsInput) (*dynamodb.ListContributorInsightsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ListContributorInsightsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListContributorInsightsInput) *dynamodb.ListContributorInsightsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListContributorInsightsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListContributorInsightsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListContributorInsightsPages provides a mock function with given fields: _a0, _a1
+func (_m *DynamoDBAPI) ListContributorInsightsPages(_a0 *dynamodb.ListContributorInsightsInput, _a1 func(*dynamodb.ListContributorInsightsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListContributorInsightsInput, func(*dynamodb.ListContributorInsightsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListContributorInsightsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DynamoDBAPI) ListContributorInsightsPagesWithContext(_a0 aws.Context, _a1 *dynamodb.ListContributorInsightsInput, _a2 func(*dynamodb.ListContributorInsightsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListContributorInsightsInput, func(*dynamodb.ListContributorInsightsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListContributorInsightsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListContributorInsightsRequest(_a0 *dynamodb.ListContributorInsightsInput) (*request.Request, *dynamodb.ListContributorInsightsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListContributorInsightsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ListContributorInsightsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListContributorInsightsInput) *dynamodb.ListContributorInsightsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ListContributorInsightsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListContributorInsightsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ListContributorInsightsWithContext(_a0 aws.Context, _a1 *dynamodb.ListContributorInsightsInput, _a2 ...request.Option) (*dynamodb.ListContributorInsightsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ListContributorInsightsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListContributorInsightsInput, ...request.Option) *dynamodb.ListContributorInsightsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListContributorInsightsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ListContributorInsightsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExports provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListExports(_a0 *dynamodb.ListExportsInput) (*dynamodb.ListExportsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ListExportsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListExportsInput) *dynamodb.ListExportsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListExportsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListExportsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExportsPages provides a mock function with given fields: _a0, _a1
+func (_m *DynamoDBAPI) ListExportsPages(_a0 *dynamodb.ListExportsInput, _a1 func(*dynamodb.ListExportsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListExportsInput, func(*dynamodb.ListExportsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListExportsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DynamoDBAPI) ListExportsPagesWithContext(_a0 aws.Context, _a1 *dynamodb.ListExportsInput, _a2 func(*dynamodb.ListExportsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListExportsInput, func(*dynamodb.ListExportsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListExportsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListExportsRequest(_a0 *dynamodb.ListExportsInput) (*request.Request, *dynamodb.ListExportsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListExportsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ListExportsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListExportsInput) *dynamodb.ListExportsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ListExportsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListExportsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ListExportsWithContext(_a0 aws.Context, _a1 *dynamodb.ListExportsInput, _a2 ...request.Option) (*dynamodb.ListExportsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ListExportsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListExportsInput, ...request.Option) *dynamodb.ListExportsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListExportsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ListExportsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListGlobalTables provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListGlobalTables(_a0 *dynamodb.ListGlobalTablesInput) (*dynamodb.ListGlobalTablesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ListGlobalTablesOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListGlobalTablesInput) *dynamodb.ListGlobalTablesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListGlobalTablesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListGlobalTablesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListGlobalTablesRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListGlobalTablesRequest(_a0 *dynamodb.ListGlobalTablesInput) (*request.Request, *dynamodb.ListGlobalTablesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListGlobalTablesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ListGlobalTablesOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListGlobalTablesInput) *dynamodb.ListGlobalTablesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ListGlobalTablesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListGlobalTablesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ListGlobalTablesWithContext(_a0 aws.Context, _a1 *dynamodb.ListGlobalTablesInput, _a2 ...request.Option) (*dynamodb.ListGlobalTablesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ListGlobalTablesOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListGlobalTablesInput, ...request.Option) *dynamodb.ListGlobalTablesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListGlobalTablesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ListGlobalTablesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListImports provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListImports(_a0 *dynamodb.ListImportsInput) (*dynamodb.ListImportsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ListImportsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListImportsInput) *dynamodb.ListImportsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListImportsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListImportsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListImportsPages provides a mock function with given fields: _a0, _a1
+func (_m *DynamoDBAPI) ListImportsPages(_a0 *dynamodb.ListImportsInput, _a1 func(*dynamodb.ListImportsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListImportsInput, func(*dynamodb.ListImportsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListImportsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DynamoDBAPI) ListImportsPagesWithContext(_a0 aws.Context, _a1 *dynamodb.ListImportsInput, _a2 func(*dynamodb.ListImportsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListImportsInput, func(*dynamodb.ListImportsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListImportsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListImportsRequest(_a0 *dynamodb.ListImportsInput) (*request.Request, *dynamodb.ListImportsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListImportsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ListImportsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListImportsInput) *dynamodb.ListImportsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ListImportsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListImportsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ListImportsWithContext(_a0 aws.Context, _a1 *dynamodb.ListImportsInput, _a2 ...request.Option) (*dynamodb.ListImportsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ListImportsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListImportsInput, ...request.Option) *dynamodb.ListImportsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListImportsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ListImportsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTables provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListTables(_a0 *dynamodb.ListTablesInput) (*dynamodb.ListTablesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ListTablesOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListTablesInput) *dynamodb.ListTablesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListTablesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListTablesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTablesPages provides a mock function with given fields: _a0, _a1
+func (_m *DynamoDBAPI) ListTablesPages(_a0 *dynamodb.ListTablesInput, _a1 func(*dynamodb.ListTablesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListTablesInput, func(*dynamodb.ListTablesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListTablesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DynamoDBAPI) ListTablesPagesWithContext(_a0 aws.Context, _a1 *dynamodb.ListTablesInput, _a2 func(*dynamodb.ListTablesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListTablesInput, func(*dynamodb.ListTablesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListTablesRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListTablesRequest(_a0 *dynamodb.ListTablesInput) (*request.Request, *dynamodb.ListTablesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListTablesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ListTablesOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListTablesInput) *dynamodb.ListTablesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ListTablesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListTablesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ListTablesWithContext(_a0 aws.Context, _a1 *dynamodb.ListTablesInput, _a2 ...request.Option) (*dynamodb.ListTablesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ListTablesOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListTablesInput, ...request.Option) *dynamodb.ListTablesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListTablesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ListTablesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTagsOfResource provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListTagsOfResource(_a0 *dynamodb.ListTagsOfResourceInput) (*dynamodb.ListTagsOfResourceOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ListTagsOfResourceOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListTagsOfResourceInput) *dynamodb.ListTagsOfResourceOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListTagsOfResourceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListTagsOfResourceInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTagsOfResourceRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListTagsOfResourceRequest(_a0 *dynamodb.ListTagsOfResourceInput) (*request.Request, *dynamodb.ListTagsOfResourceOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListTagsOfResourceInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ListTagsOfResourceOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListTagsOfResourceInput) *dynamodb.ListTagsOfResourceOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ListTagsOfResourceOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListTagsOfResourceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ListTagsOfResourceWithContext(_a0 aws.Context, _a1 *dynamodb.ListTagsOfResourceInput, _a2 ...request.Option) (*dynamodb.ListTagsOfResourceOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ListTagsOfResourceOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListTagsOfResourceInput, ...request.Option) *dynamodb.ListTagsOfResourceOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListTagsOfResourceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ListTagsOfResourceInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutItem provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) PutItem(_a0 *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.PutItemOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.PutItemInput) *dynamodb.PutItemOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.PutItemOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.PutItemInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutItemRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) PutItemRequest(_a0 *dynamodb.PutItemInput) (*request.Request, *dynamodb.PutItemOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.PutItemInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.PutItemOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.PutItemInput) *dynamodb.PutItemOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.PutItemOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// PutItemWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) PutItemWithContext(_a0 aws.Context, _a1 *dynamodb.PutItemInput, _a2 ...request.Option) (*dynamodb.PutItemOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.PutItemOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.PutItemInput, ...request.Option) *dynamodb.PutItemOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.PutItemOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.PutItemInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutResourcePolicy provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) PutResourcePolicy(_a0 *dynamodb.PutResourcePolicyInput) (*dynamodb.PutResourcePolicyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.PutResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.PutResourcePolicyInput) *dynamodb.PutResourcePolicyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.PutResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.PutResourcePolicyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutResourcePolicyRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) PutResourcePolicyRequest(_a0 *dynamodb.PutResourcePolicyInput) (*request.Request, *dynamodb.PutResourcePolicyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.PutResourcePolicyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.PutResourcePolicyOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.PutResourcePolicyInput) *dynamodb.PutResourcePolicyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.PutResourcePolicyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// PutResourcePolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) PutResourcePolicyWithContext(_a0 aws.Context, _a1 *dynamodb.PutResourcePolicyInput, _a2 ...request.Option) (*dynamodb.PutResourcePolicyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.PutResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.PutResourcePolicyInput, ...request.Option) *dynamodb.PutResourcePolicyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.PutResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.PutResourcePolicyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Query provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) Query(_a0 *dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.QueryOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.QueryInput) *dynamodb.QueryOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.QueryOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.QueryInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryPages provides a mock function with given fields: _a0, _a1
+func (_m *DynamoDBAPI) QueryPages(_a0 *dynamodb.QueryInput, _a1 func(*dynamodb.QueryOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dynamodb.QueryInput, func(*dynamodb.QueryOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // QueryPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
 func (_m *DynamoDBAPI) QueryPagesWithContext(_a0 aws.Context, _a1 *dynamodb.QueryInput, _a2 func(*dynamodb.QueryOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
@@ -1691,7 +3356,276 @@ func (_m *DynamoDBAPI) QueryPagesWithContext(_a0 aws.Context, _a1 *dynamodb.Quer
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.QueryInput, func(*dynamodb.QueryOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.QueryInput, func(*dynamodb.QueryOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QueryRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) QueryRequest(_a0 *dynamodb.QueryInput) (*request.Request, *dynamodb.QueryOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.QueryInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.QueryOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.QueryInput) *dynamodb.QueryOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.QueryOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// QueryWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) QueryWithContext(_a0 aws.Context, _a1 *dynamodb.QueryInput, _a2 ...request.Option) (*dynamodb.QueryOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.QueryOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.QueryInput, ...request.Option) *dynamodb.QueryOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.QueryOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.QueryInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreTableFromBackup provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) RestoreTableFromBackup(_a0 *dynamodb.RestoreTableFromBackupInput) (*dynamodb.RestoreTableFromBackupOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.RestoreTableFromBackupOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.RestoreTableFromBackupInput) *dynamodb.RestoreTableFromBackupOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.RestoreTableFromBackupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.RestoreTableFromBackupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreTableFromBackupRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) RestoreTableFromBackupRequest(_a0 *dynamodb.RestoreTableFromBackupInput) (*request.Request, *dynamodb.RestoreTableFromBackupOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.RestoreTableFromBackupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.RestoreTableFromBackupOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.RestoreTableFromBackupInput) *dynamodb.RestoreTableFromBackupOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.RestoreTableFromBackupOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RestoreTableFromBackupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) RestoreTableFromBackupWithContext(_a0 aws.Context, _a1 *dynamodb.RestoreTableFromBackupInput, _a2 ...request.Option) (*dynamodb.RestoreTableFromBackupOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.RestoreTableFromBackupOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.RestoreTableFromBackupInput, ...request.Option) *dynamodb.RestoreTableFromBackupOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.RestoreTableFromBackupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.RestoreTableFromBackupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreTableToPointInTime provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) RestoreTableToPointInTime(_a0 *dynamodb.RestoreTableToPointInTimeInput) (*dynamodb.RestoreTableToPointInTimeOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.RestoreTableToPointInTimeOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.RestoreTableToPointInTimeInput) *dynamodb.RestoreTableToPointInTimeOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.RestoreTableToPointInTimeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.RestoreTableToPointInTimeInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreTableToPointInTimeRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) RestoreTableToPointInTimeRequest(_a0 *dynamodb.RestoreTableToPointInTimeInput) (*request.Request, *dynamodb.RestoreTableToPointInTimeOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.RestoreTableToPointInTimeInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.RestoreTableToPointInTimeOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.RestoreTableToPointInTimeInput) *dynamodb.RestoreTableToPointInTimeOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.RestoreTableToPointInTimeOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RestoreTableToPointInTimeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) RestoreTableToPointInTimeWithContext(_a0 aws.Context, _a1 *dynamodb.RestoreTableToPointInTimeInput, _a2 ...request.Option) (*dynamodb.RestoreTableToPointInTimeOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.RestoreTableToPointInTimeOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.RestoreTableToPointInTimeInput, ...request.Option) *dynamodb.RestoreTableToPointInTimeOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.RestoreTableToPointInTimeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.RestoreTableToPointInTimeInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Scan provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) Scan(_a0 *dynamodb.ScanInput) (*dynamodb.ScanOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ScanOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ScanInput) *dynamodb.ScanOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ScanOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ScanInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScanPages provides a mock function with given fields: _a0, _a1
+func (_m *DynamoDBAPI) ScanPages(_a0 *dynamodb.ScanInput, _a1 func(*dynamodb.ScanOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dynamodb.ScanInput, func(*dynamodb.ScanOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ScanPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DynamoDBAPI) ScanPagesWithContext(_a0 aws.Context, _a1 *dynamodb.ScanInput, _a2 func(*dynamodb.ScanOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ScanInput, func(*dynamodb.ScanOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -1700,12 +3634,12 @@ func (_m *DynamoDBAPI) QueryPagesWithContext(_a0 aws.Context, _a1 *dynamodb.Quer
 	return r0
 }
 
-// QueryRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) QueryRequest(_a0 *dynamodb.QueryInput) (*request.Request, *dynamodb.QueryOutput) {
+// ScanRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ScanRequest(_a0 *dynamodb.ScanInput) (*request.Request, *dynamodb.ScanOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.QueryInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.ScanInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1713,20 +3647,20 @@ func (_m *DynamoDBAPI) QueryRequest(_a0 *dynamodb.QueryInput) (*request.Request,
 		}
 	}
 
-	var r1 *dynamodb.QueryOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.QueryInput) *dynamodb.QueryOutput); ok {
+	var r1 *dynamodb.ScanOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ScanInput) *dynamodb.ScanOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.QueryOutput)
+			r1 = ret.Get(1).(*dynamodb.ScanOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// QueryWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) QueryWithContext(_a0 aws.Context, _a1 *dynamodb.QueryInput, _a2 ...request.Option) (*dynamodb.QueryOutput, error) {
+// ScanWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ScanWithContext(_a0 aws.Context, _a1 *dynamodb.ScanInput, _a2 ...request.Option) (*dynamodb.ScanOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1736,17 +3670,17 @@ func (_m *DynamoDBAPI) QueryWithContext(_a0 aws.Context, _a1 *dynamodb.QueryInpu
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.QueryOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.QueryInput, ...request.Option) *dynamodb.QueryOutput); ok {
+	var r0 *dynamodb.ScanOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ScanInput, ...request.Option) *dynamodb.ScanOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.QueryOutput)
+			r0 = ret.Get(0).(*dynamodb.ScanOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.QueryInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ScanInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1755,21 +3689,21 @@ func (_m *DynamoDBAPI) QueryWithContext(_a0 aws.Context, _a1 *dynamodb.QueryInpu
 	return r0, r1
 }
 
-// RestoreTableFromBackup provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) RestoreTableFromBackup(_a0 *dynamodb.RestoreTableFromBackupInput) (*dynamodb.RestoreTableFromBackupOutput, error) {
+// TagResource provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) TagResource(_a0 *dynamodb.TagResourceInput) (*dynamodb.TagResourceOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.RestoreTableFromBackupOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.RestoreTableFromBackupInput) *dynamodb.RestoreTableFromBackupOutput); ok {
+	var r0 *dynamodb.TagResourceOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.TagResourceInput) *dynamodb.TagResourceOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.RestoreTableFromBackupOutput)
+			r0 = ret.Get(0).(*dynamodb.TagResourceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.RestoreTableFromBackupInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.TagResourceInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1778,12 +3712,12 @@ func (_m *DynamoDBAPI) RestoreTableFromBackup(_a0 *dynamodb.RestoreTableFromBack
 	return r0, r1
 }
 
-// RestoreTableFromBackupRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) RestoreTableFromBackupRequest(_a0 *dynamodb.RestoreTableFromBackupInput) (*request.Request, *dynamodb.RestoreTableFromBackupOutput) {
+// TagResourceRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) TagResourceRequest(_a0 *dynamodb.TagResourceInput) (*request.Request, *dynamodb.TagResourceOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.RestoreTableFromBackupInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.TagResourceInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1791,20 +3725,20 @@ func (_m *DynamoDBAPI) RestoreTableFromBackupRequest(_a0 *dynamodb.RestoreTableF
 		}
 	}
 
-	var r1 *dynamodb.RestoreTableFromBackupOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.RestoreTableFromBackupInput) *dynamodb.RestoreTableFromBackupOutput); ok {
+	var r1 *dynamodb.TagResourceOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.TagResourceInput) *dynamodb.TagResourceOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.RestoreTableFromBackupOutput)
+			r1 = ret.Get(1).(*dynamodb.TagResourceOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// RestoreTableFromBackupWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) RestoreTableFromBackupWithContext(_a0 aws.Context, _a1 *dynamodb.RestoreTableFromBackupInput, _a2 ...request.Option) (*dynamodb.RestoreTableFromBackupOutput, error) {
+// TagResourceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) TagResourceWithContext(_a0 aws.Context, _a1 *dynamodb.TagResourceInput, _a2 ...request.Option) (*dynamodb.TagResourceOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1814,17 +3748,17 @@ func (_m *DynamoDBAPI) RestoreTableFromBackupWithContext(_a0 aws.Context, _a1 *d
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.RestoreTableFromBackupOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.RestoreTableFromBackupInput, ...request.Option) *dynamodb.RestoreTableFromBackupOutput); ok {
+	var r0 *dynamodb.TagResourceOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.TagResourceInput, ...request.Option) *dynamodb.TagResourceOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.RestoreTableFromBackupOutput)
+			r0 = ret.Get(0).(*dynamodb.TagResourceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.RestoreTableFromBackupInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.TagResourceInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1833,21 +3767,21 @@ func (_m *DynamoDBAPI) RestoreTableFromBackupWithContext(_a0 aws.Context, _a1 *d
 	return r0, r1
 }
 
-// Scan provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) Scan(_a0 *dynamodb.ScanInput) (*dynamodb.ScanOutput, error) {
+// TransactGetItems provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) TransactGetItems(_a0 *dynamodb.TransactGetItemsInput) (*dynamodb.TransactGetItemsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.ScanOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.ScanInput) *dynamodb.ScanOutput); ok {
+	var r0 *dynamodb.TransactGetItemsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.TransactGetItemsInput) *dynamodb.TransactGetItemsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.ScanOutput)
+			r0 = ret.Get(0).(*dynamodb.TransactGetItemsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.ScanInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.TransactGetItemsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1856,47 +3790,168 @@ func (_m *DynamoDBAPI) Scan(_a0 *dynamodb.ScanInput) (*dynamodb.ScanOutput, erro
 	return r0, r1
 }
 
-// ScanPages provides a mock function with given fields: _a0, _a1
-func (_m *DynamoDBAPI) ScanPages(_a0 *dynamodb.ScanInput, _a1 func(*dynamodb.ScanOutput, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
+// TransactGetItemsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) TransactGetItemsRequest(_a0 *dynamodb.TransactGetItemsInput) (*request.Request, *dynamodb.TransactGetItemsOutput) {
+	ret := _m.Called(_a0)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*dynamodb.ScanInput, func(*dynamodb.ScanOutput, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.TransactGetItemsInput) *request.Request); ok {
+		r0 = rf(_a0)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
 	}
 
-	return r0
+	var r1 *dynamodb.TransactGetItemsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.TransactGetItemsInput) *dynamodb.TransactGetItemsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.TransactGetItemsOutput)
+		}
+	}
+
+	return r0, r1
 }
 
-// ScanPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *DynamoDBAPI) ScanPagesWithContext(_a0 aws.Context, _a1 *dynamodb.ScanInput, _a2 func(*dynamodb.ScanOutput, bool) bool, _a3 ...request.Option) error {
-	_va := make([]interface{}, len(_a3))
-	for _i := range _a3 {
-		_va[_i] = _a3[_i]
+// TransactGetItemsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) TransactGetItemsWithContext(_a0 aws.Context, _a1 *dynamodb.TransactGetItemsInput, _a2 ...request.Option) (*dynamodb.TransactGetItemsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.TransactGetItemsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.TransactGetItemsInput, ...request.Option) *dynamodb.TransactGetItemsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.TransactGetItemsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.TransactGetItemsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransactWriteItems provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) TransactWriteItems(_a0 *dynamodb.TransactWriteItemsInput) (*dynamodb.TransactWriteItemsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.TransactWriteItemsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.TransactWriteItemsInput) *dynamodb.TransactWriteItemsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.TransactWriteItemsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.TransactWriteItemsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransactWriteItemsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) TransactWriteItemsRequest(_a0 *dynamodb.TransactWriteItemsInput) (*request.Request, *dynamodb.TransactWriteItemsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.TransactWriteItemsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.TransactWriteItemsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.TransactWriteItemsInput) *dynamodb.TransactWriteItemsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.TransactWriteItemsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// TransactWriteItemsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) TransactWriteItemsWithContext(_a0 aws.Context, _a1 *dynamodb.TransactWriteItemsInput, _a2 ...request.Option) (*dynamodb.TransactWriteItemsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.TransactWriteItemsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.TransactWriteItemsInput, ...request.Option) *dynamodb.TransactWriteItemsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.TransactWriteItemsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.TransactWriteItemsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UntagResource provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) UntagResource(_a0 *dynamodb.UntagResourceInput) (*dynamodb.UntagResourceOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.UntagResourceOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.UntagResourceInput) *dynamodb.UntagResourceOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.UntagResourceOutput)
+		}
 	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1, _a2)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ScanInput, func(*dynamodb.ScanOutput, bool) bool, ...request.Option) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3...)
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.UntagResourceInput) error); ok {
+		r1 = rf(_a0)
 	} else {
-		r0 = ret.Error(0)
+		r1 = ret.Error(1)
 	}
 
-	return r0
+	return r0, r1
 }
 
-// ScanRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) ScanRequest(_a0 *dynamodb.ScanInput) (*request.Request, *dynamodb.ScanOutput) {
+// UntagResourceRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) UntagResourceRequest(_a0 *dynamodb.UntagResourceInput) (*request.Request, *dynamodb.UntagResourceOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.ScanInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.UntagResourceInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1904,20 +3959,20 @@ func (_m *DynamoDBAPI) ScanRequest(_a0 *dynamodb.ScanInput) (*request.Request, *
 		}
 	}
 
-	var r1 *dynamodb.ScanOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.ScanInput) *dynamodb.ScanOutput); ok {
+	var r1 *dynamodb.UntagResourceOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.UntagResourceInput) *dynamodb.UntagResourceOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.ScanOutput)
+			r1 = ret.Get(1).(*dynamodb.UntagResourceOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ScanWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) ScanWithContext(_a0 aws.Context, _a1 *dynamodb.ScanInput, _a2 ...request.Option) (*dynamodb.ScanOutput, error) {
+// UntagResourceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) UntagResourceWithContext(_a0 aws.Context, _a1 *dynamodb.UntagResourceInput, _a2 ...request.Option) (*dynamodb.UntagResourceOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1927,17 +3982,17 @@ func (_m *DynamoDBAPI) ScanWithContext(_a0 aws.Context, _a1 *dynamodb.ScanInput,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.ScanOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ScanInput, ...request.Option) *dynamodb.ScanOutput); ok {
+	var r0 *dynamodb.UntagResourceOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.UntagResourceInput, ...request.Option) *dynamodb.UntagResourceOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.ScanOutput)
+			r0 = ret.Get(0).(*dynamodb.UntagResourceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ScanInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.UntagResourceInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1946,21 +4001,21 @@ func (_m *DynamoDBAPI) ScanWithContext(_a0 aws.Context, _a1 *dynamodb.ScanInput,
 	return r0, r1
 }
 
-// TagResource provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) TagResource(_a0 *dynamodb.TagResourceInput) (*dynamodb.TagResourceOutput, error) {
+// UpdateContinuousBackups provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) UpdateContinuousBackups(_a0 *dynamodb.UpdateContinuousBackupsInput) (*dynamodb.UpdateContinuousBackupsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.TagResourceOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.TagResourceInput) *dynamodb.TagResourceOutput); ok {
+	var r0 *dynamodb.UpdateContinuousBackupsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.UpdateContinuousBackupsInput) *dynamodb.UpdateContinuousBackupsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.TagResourceOutput)
+			r0 = ret.Get(0).(*dynamodb.UpdateContinuousBackupsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.TagResourceInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.UpdateContinuousBackupsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1969,12 +4024,12 @@ func (_m *DynamoDBAPI) TagResource(_a0 *dynamodb.TagResourceInput) (*dynamodb.Ta
 	return r0, r1
 }
 
-// TagResourceRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) TagResourceRequest(_a0 *dynamodb.TagResourceInput) (*request.Request, *dynamodb.TagResourceOutput) {
+// UpdateContinuousBackupsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) UpdateContinuousBackupsRequest(_a0 *dynamodb.UpdateContinuousBackupsInput) (*request.Request, *dynamodb.UpdateContinuousBackupsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.TagResourceInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.UpdateContinuousBackupsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1982,20 +4037,20 @@ func (_m *DynamoDBAPI) TagResourceRequest(_a0 *dynamodb.TagResourceInput) (*requ
 		}
 	}
 
-	var r1 *dynamodb.TagResourceOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.TagResourceInput) *dynamodb.TagResourceOutput); ok {
+	var r1 *dynamodb.UpdateContinuousBackupsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.UpdateContinuousBackupsInput) *dynamodb.UpdateContinuousBackupsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.TagResourceOutput)
+			r1 = ret.Get(1).(*dynamodb.UpdateContinuousBackupsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// TagResourceWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) TagResourceWithContext(_a0 aws.Context, _a1 *dynamodb.TagResourceInput, _a2 ...request.Option) (*dynamodb.TagResourceOutput, error) {
+// UpdateContinuousBackupsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) UpdateContinuousBackupsWithContext(_a0 aws.Context, _a1 *dynamodb.UpdateContinuousBackupsInput, _a2 ...request.Option) (*dynamodb.UpdateContinuousBackupsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2005,17 +4060,17 @@ func (_m *DynamoDBAPI) TagResourceWithContext(_a0 aws.Context, _a1 *dynamodb.Tag
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.TagResourceOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.TagResourceInput, ...request.Option) *dynamodb.TagResourceOutput); ok {
+	var r0 *dynamodb.UpdateContinuousBackupsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.UpdateContinuousBackupsInput, ...request.Option) *dynamodb.UpdateContinuousBackupsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.TagResourceOutput)
+			r0 = ret.Get(0).(*dynamodb.UpdateContinuousBackupsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.TagResourceInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.UpdateContinuousBackupsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2024,21 +4079,21 @@ func (_m *DynamoDBAPI) TagResourceWithContext(_a0 aws.Context, _a1 *dynamodb.Tag
 	return r0, r1
 }
 
-// UntagResource provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) UntagResource(_a0 *dynamodb.UntagResourceInput) (*dynamodb.UntagResourceOutput, error) {
+// UpdateContributorInsights provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) UpdateContributorInsights(_a0 *dynamodb.UpdateContributorInsightsInput) (*dynamodb.UpdateContributorInsightsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.UntagResourceOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.UntagResourceInput) *dynamodb.UntagResourceOutput); ok {
+	var r0 *dynamodb.UpdateContributorInsightsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.UpdateContributorInsightsInput) *dynamodb.UpdateContributorInsightsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.UntagResourceOutput)
+			r0 = ret.Get(0).(*dynamodb.UpdateContributorInsightsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.UntagResourceInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.UpdateContributorInsightsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2047,12 +4102,12 @@ func (_m *DynamoDBAPI) UntagResource(_a0 *dynamodb.UntagResourceInput) (*dynamod
 	return r0, r1
 }
 
-// UntagResourceRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) UntagResourceRequest(_a0 *dynamodb.UntagResourceInput) (*request.Request, *dynamodb.UntagResourceOutput) {
+// UpdateContributorInsightsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) UpdateContributorInsightsRequest(_a0 *dynamodb.UpdateContributorInsightsInput) (*request.Request, *dynamodb.UpdateContributorInsightsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.UntagResourceInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.UpdateContributorInsightsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2060,20 +4115,20 @@ func (_m *DynamoDBAPI) UntagResourceRequest(_a0 *dynamodb.UntagResourceInput) (*
 		}
 	}
 
-	var r1 *dynamodb.UntagResourceOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.UntagResourceInput) *dynamodb.UntagResourceOutput); ok {
+	var r1 *dynamodb.UpdateContributorInsightsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.UpdateContributorInsightsInput) *dynamodb.UpdateContributorInsightsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.UntagResourceOutput)
+			r1 = ret.Get(1).(*dynamodb.UpdateContributorInsightsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// UntagResourceWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) UntagResourceWithContext(_a0 aws.Context, _a1 *dynamodb.UntagResourceInput, _a2 ...request.Option) (*dynamodb.UntagResourceOutput, error) {
+// UpdateContributorInsightsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) UpdateContributorInsightsWithContext(_a0 aws.Context, _a1 *dynamodb.UpdateContributorInsightsInput, _a2 ...request.Option) (*dynamodb.UpdateContributorInsightsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2083,17 +4138,17 @@ func (_m *DynamoDBAPI) UntagResourceWithContext(_a0 aws.Context, _a1 *dynamodb.U
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.UntagResourceOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.UntagResourceInput, ...request.Option) *dynamodb.UntagResourceOutput); ok {
+	var r0 *dynamodb.UpdateContributorInsightsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.UpdateContributorInsightsInput, ...request.Option) *dynamodb.UpdateContributorInsightsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.UntagResourceOutput)
+			r0 = ret.Get(0).(*dynamodb.UpdateContributorInsightsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.UntagResourceInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.UpdateContributorInsightsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2150,6 +4205,84 @@ func (_m *DynamoDBAPI) UpdateGlobalTableRequest(_a0 *dynamodb.UpdateGlobalTableI
 	return r0, r1
 }
 
+// UpdateGlobalTableSettings provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) UpdateGlobalTableSettings(_a0 *dynamodb.UpdateGlobalTableSettingsInput) (*dynamodb.UpdateGlobalTableSettingsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.UpdateGlobalTableSettingsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.UpdateGlobalTableSettingsInput) *dynamodb.UpdateGlobalTableSettingsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.UpdateGlobalTableSettingsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.UpdateGlobalTableSettingsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateGlobalTableSettingsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) UpdateGlobalTableSettingsRequest(_a0 *dynamodb.UpdateGlobalTableSettingsInput) (*request.Request, *dynamodb.UpdateGlobalTableSettingsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.UpdateGlobalTableSettingsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.UpdateGlobalTableSettingsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.UpdateGlobalTableSettingsInput) *dynamodb.UpdateGlobalTableSettingsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.UpdateGlobalTableSettingsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateGlobalTableSettingsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) UpdateGlobalTableSettingsWithContext(_a0 aws.Context, _a1 *dynamodb.UpdateGlobalTableSettingsInput, _a2 ...request.Option) (*dynamodb.UpdateGlobalTableSettingsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.UpdateGlobalTableSettingsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.UpdateGlobalTableSettingsInput, ...request.Option) *dynamodb.UpdateGlobalTableSettingsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.UpdateGlobalTableSettingsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.UpdateGlobalTableSettingsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateGlobalTableWithContext provides a mock function with given fields: _a0, _a1, _a2
 func (_m *DynamoDBAPI) UpdateGlobalTableWithContext(_a0 aws.Context, _a1 *dynamodb.UpdateGlobalTableInput, _a2 ...request.Option) (*dynamodb.UpdateGlobalTableOutput, error) {
 	_va := make([]interface{}, len(_a2))
@@ -2258,6 +4391,84 @@ func (_m *DynamoDBAPI) UpdateItemWithContext(_a0 aws.Context, _a1 *dynamodb.Upda
 	return r0, r1
 }
 
+// UpdateKinesisStreamingDestination provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) UpdateKinesisStreamingDestination(_a0 *dynamodb.UpdateKinesisStreamingDestinationInput) (*dynamodb.UpdateKinesisStreamingDestinationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.UpdateKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.UpdateKinesisStreamingDestinationInput) *dynamodb.UpdateKinesisStreamingDestinationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.UpdateKinesisStreamingDestinationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.UpdateKinesisStreamingDestinationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateKinesisStreamingDestinationRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) UpdateKinesisStreamingDestinationRequest(_a0 *dynamodb.UpdateKinesisStreamingDestinationInput) (*request.Request, *dynamodb.UpdateKinesisStreamingDestinationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.UpdateKinesisStreamingDestinationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.UpdateKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.UpdateKinesisStreamingDestinationInput) *dynamodb.UpdateKinesisStreamingDestinationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.UpdateKinesisStreamingDestinationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateKinesisStreamingDestinationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) UpdateKinesisStreamingDestinationWithContext(_a0 aws.Context, _a1 *dynamodb.UpdateKinesisStreamingDestinationInput, _a2 ...request.Option) (*dynamodb.UpdateKinesisStreamingDestinationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.UpdateKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.UpdateKinesisStreamingDestinationInput, ...request.Option) *dynamodb.UpdateKinesisStreamingDestinationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.UpdateKinesisStreamingDestinationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.UpdateKinesisStreamingDestinationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateTable provides a mock function with given fields: _a0
 func (_m *DynamoDBAPI) UpdateTable(_a0 *dynamodb.UpdateTableInput) (*dynamodb.UpdateTableOutput, error) {
 	ret := _m.Called(_a0)
@@ -2281,6 +4492,84 @@ func (_m *DynamoDBAPI) UpdateTable(_a0 *dynamodb.UpdateTableInput) (*dynamodb.Up
 	return r0, r1
 }
 
+// UpdateTableReplicaAutoScaling provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) UpdateTableReplicaAutoScaling(_a0 *dynamodb.UpdateTableReplicaAutoScalingInput) (*dynamodb.UpdateTableReplicaAutoScalingOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.UpdateTableReplicaAutoScalingOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.UpdateTableReplicaAutoScalingInput) *dynamodb.UpdateTableReplicaAutoScalingOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.UpdateTableReplicaAutoScalingOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.UpdateTableReplicaAutoScalingInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateTableReplicaAutoScalingRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) UpdateTableReplicaAutoScalingRequest(_a0 *dynamodb.UpdateTableReplicaAutoScalingInput) (*request.Request, *dynamodb.UpdateTableReplicaAutoScalingOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.UpdateTableReplicaAutoScalingInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.UpdateTableReplicaAutoScalingOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.UpdateTableReplicaAutoScalingInput) *dynamodb.UpdateTableReplicaAutoScalingOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.UpdateTableReplicaAutoScalingOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateTableReplicaAutoScalingWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) UpdateTableReplicaAutoScalingWithContext(_a0 aws.Context, _a1 *dynamodb.UpdateTableReplicaAutoScalingInput, _a2 ...request.Option) (*dynamodb.UpdateTableReplicaAutoScalingOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.UpdateTableReplicaAutoScalingOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.UpdateTableReplicaAutoScalingInput, ...request.Option) *dynamodb.UpdateTableReplicaAutoScalingOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.UpdateTableReplicaAutoScalingOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.UpdateTableReplicaAutoScalingInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateTableRequest provides a mock function with given fields: _a0
 func (_m *DynamoDBAPI) UpdateTableRequest(_a0 *dynamodb.UpdateTableInput) (*request.Request, *dynamodb.UpdateTableOutput) {
 	ret := _m.Called(_a0)
@@ -0,0 +1,325 @@
+// Code generated by mockery v1.0.0
+package mocks
+
+import aws "github.com/aws/aws-sdk-go/aws"
+import dynamodbstreams "github.com/aws/aws-sdk-go/service/dynamodbstreams"
+
+import mock "github.com/stretchr/testify/mock"
+import request "github.com/aws/aws-sdk-go/aws/request"
+
+// DynamoDBStreamsAPI is an autogenerated mock type for the DynamoDBStreamsAPI type
+type DynamoDBStreamsAPI struct {
+	mock.Mock
+}
+
+// DescribeStream provides a mock function with given fields: _a0
+func (_m *DynamoDBStreamsAPI) DescribeStream(_a0 *dynamodbstreams.DescribeStreamInput) (*dynamodbstreams.DescribeStreamOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodbstreams.DescribeStreamOutput
+	if rf, ok := ret.Get(0).(func(*dynamodbstreams.DescribeStreamInput) *dynamodbstreams.DescribeStreamOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodbstreams.DescribeStreamOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodbstreams.DescribeStreamInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeStreamRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBStreamsAPI) DescribeStreamRequest(_a0 *dynamodbstreams.DescribeStreamInput) (*request.Request, *dynamodbstreams.DescribeStreamOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodbstreams.DescribeStreamInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodbstreams.DescribeStreamOutput
+	if rf, ok := ret.Get(1).(func(*dynamodbstreams.DescribeStreamInput) *dynamodbstreams.DescribeStreamOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodbstreams.DescribeStreamOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeStreamWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBStreamsAPI) DescribeStreamWithContext(_a0 aws.Context, _a1 *dynamodbstreams.DescribeStreamInput, _a2 ...request.Option) (*dynamodbstreams.DescribeStreamOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodbstreams.DescribeStreamOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodbstreams.DescribeStreamInput, ...request.Option) *dynamodbstreams.DescribeStreamOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodbstreams.DescribeStreamOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodbstreams.DescribeStreamInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRecords provides a mock function with given fields: _a0
+func (_m *DynamoDBStreamsAPI) GetRecords(_a0 *dynamodbstreams.GetRecordsInput) (*dynamodbstreams.GetRecordsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodbstreams.GetRecordsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodbstreams.GetRecordsInput) *dynamodbstreams.GetRecordsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodbstreams.GetRecordsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodbstreams.GetRecordsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRecordsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBStreamsAPI) GetRecordsRequest(_a0 *dynamodbstreams.GetRecordsInput) (*request.Request, *dynamodbstreams.GetRecordsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodbstreams.GetRecordsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodbstreams.GetRecordsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodbstreams.GetRecordsInput) *dynamodbstreams.GetRecordsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodbstreams.GetRecordsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetRecordsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBStreamsAPI) GetRecordsWithContext(_a0 aws.Context, _a1 *dynamodbstreams.GetRecordsInput, _a2 ...request.Option) (*dynamodbstreams.GetRecordsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodbstreams.GetRecordsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodbstreams.GetRecordsInput, ...request.Option) *dynamodbstreams.GetRecordsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodbstreams.GetRecordsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodbstreams.GetRecordsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShardIterator provides a mock function with given fields: _a0
+func (_m *DynamoDBStreamsAPI) GetShardIterator(_a0 *dynamodbstreams.GetShardIteratorInput) (*dynamodbstreams.GetShardIteratorOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodbstreams.GetShardIteratorOutput
+	if rf, ok := ret.Get(0).(func(*dynamodbstreams.GetShardIteratorInput) *dynamodbstreams.GetShardIteratorOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodbstreams.GetShardIteratorOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodbstreams.GetShardIteratorInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShardIteratorRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBStreamsAPI) GetShardIteratorRequest(_a0 *dynamodbstreams.GetShardIteratorInput) (*request.Request, *dynamodbstreams.GetShardIteratorOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodbstreams.GetShardIteratorInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodbstreams.GetShardIteratorOutput
+	if rf, ok := ret.Get(1).(func(*dynamodbstreams.GetShardIteratorInput) *dynamodbstreams.GetShardIteratorOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodbstreams.GetShardIteratorOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetShardIteratorWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBStreamsAPI) GetShardIteratorWithContext(_a0 aws.Context, _a1 *dynamodbstreams.GetShardIteratorInput, _a2 ...request.Option) (*dynamodbstreams.GetShardIteratorOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodbstreams.GetShardIteratorOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodbstreams.GetShardIteratorInput, ...request.Option) *dynamodbstreams.GetShardIteratorOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodbstreams.GetShardIteratorOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodbstreams.GetShardIteratorInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStreams provides a mock function with given fields: _a0
+func (_m *DynamoDBStreamsAPI) ListStreams(_a0 *dynamodbstreams.ListStreamsInput) (*dynamodbstreams.ListStreamsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodbstreams.ListStreamsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodbstreams.ListStreamsInput) *dynamodbstreams.ListStreamsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodbstreams.ListStreamsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodbstreams.ListStreamsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStreamsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBStreamsAPI) ListStreamsRequest(_a0 *dynamodbstreams.ListStreamsInput) (*request.Request, *dynamodbstreams.ListStreamsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodbstreams.ListStreamsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodbstreams.ListStreamsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodbstreams.ListStreamsInput) *dynamodbstreams.ListStreamsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodbstreams.ListStreamsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListStreamsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBStreamsAPI) ListStreamsWithContext(_a0 aws.Context, _a1 *dynamodbstreams.ListStreamsInput, _a2 ...request.Option) (*dynamodbstreams.ListStreamsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodbstreams.ListStreamsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodbstreams.ListStreamsInput, ...request.Option) *dynamodbstreams.ListStreamsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodbstreams.ListStreamsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodbstreams.ListStreamsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -90,6 +90,84 @@ func (_m *KMSAPI) CancelKeyDeletionWithContext(_a0 aws.Context, _a1 *kms.CancelK
 	return r0, r1
 }
 
+// ConnectCustomKeyStore provides a mock function with given fields: _a0
+func (_m *KMSAPI) ConnectCustomKeyStore(_a0 *kms.ConnectCustomKeyStoreInput) (*kms.ConnectCustomKeyStoreOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *kms.ConnectCustomKeyStoreOutput
+	if rf, ok := ret.Get(0).(func(*kms.ConnectCustomKeyStoreInput) *kms.ConnectCustomKeyStoreOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.ConnectCustomKeyStoreOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*kms.ConnectCustomKeyStoreInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConnectCustomKeyStoreRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) ConnectCustomKeyStoreRequest(_a0 *kms.ConnectCustomKeyStoreInput) (*request.Request, *kms.ConnectCustomKeyStoreOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*kms.ConnectCustomKeyStoreInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *kms.ConnectCustomKeyStoreOutput
+	if rf, ok := ret.Get(1).(func(*kms.ConnectCustomKeyStoreInput) *kms.ConnectCustomKeyStoreOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*kms.ConnectCustomKeyStoreOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ConnectCustomKeyStoreWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) ConnectCustomKeyStoreWithContext(_a0 aws.Context, _a1 *kms.ConnectCustomKeyStoreInput, _a2 ...request.Option) (*kms.ConnectCustomKeyStoreOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *kms.ConnectCustomKeyStoreOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ConnectCustomKeyStoreInput, ...request.Option) *kms.ConnectCustomKeyStoreOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.ConnectCustomKeyStoreOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.ConnectCustomKeyStoreInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateAlias provides a mock function with given fields: _a0
 func (_m *KMSAPI) CreateAlias(_a0 *kms.CreateAliasInput) (*kms.CreateAliasOutput, error) {
 	ret := _m.Called(_a0)
@@ -168,6 +246,84 @@ func (_m *KMSAPI) CreateAliasWithContext(_a0 aws.Context, _a1 *kms.CreateAliasIn
 	return r0, r1
 }
 
+// CreateCustomKeyStore provides a mock function with given fields: _a0
+func (_m *KMSAPI) CreateCustomKeyStore(_a0 *kms.CreateCustomKeyStoreInput) (*kms.CreateCustomKeyStoreOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *kms.CreateCustomKeyStoreOutput
+	if rf, ok := ret.Get(0).(func(*kms.CreateCustomKeyStoreInput) *kms.CreateCustomKeyStoreOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.CreateCustomKeyStoreOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*kms.CreateCustomKeyStoreInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCustomKeyStoreRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) CreateCustomKeyStoreRequest(_a0 *kms.CreateCustomKeyStoreInput) (*request.Request, *kms.CreateCustomKeyStoreOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*kms.CreateCustomKeyStoreInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *kms.CreateCustomKeyStoreOutput
+	if rf, ok := ret.Get(1).(func(*kms.CreateCustomKeyStoreInput) *kms.CreateCustomKeyStoreOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*kms.CreateCustomKeyStoreOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateCustomKeyStoreWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) CreateCustomKeyStoreWithContext(_a0 aws.Context, _a1 *kms.CreateCustomKeyStoreInput, _a2 ...request.Option) (*kms.CreateCustomKeyStoreOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *kms.CreateCustomKeyStoreOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.CreateCustomKeyStoreInput, ...request.Option) *kms.CreateCustomKeyStoreOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.CreateCustomKeyStoreOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.CreateCustomKeyStoreInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateGrant provides a mock function with given fields: _a0
 func (_m *KMSAPI) CreateGrant(_a0 *kms.CreateGrantInput) (*kms.CreateGrantOutput, error) {
 	ret := _m.Called(_a0)
@@ -480,21 +636,21 @@ func (_m *KMSAPI) DeleteAliasWithContext(_a0 aws.Context, _a1 *kms.DeleteAliasIn
 	return r0, r1
 }
 
-// DeleteImportedKeyMaterial provides a mock function with given fields: _a0
-func (_m *KMSAPI) DeleteImportedKeyMaterial(_a0 *kms.DeleteImportedKeyMaterialInput) (*kms.DeleteImportedKeyMaterialOutput, error) {
+// DeleteCustomKeyStore provides a mock function with given fields: _a0
+func (_m *KMSAPI) DeleteCustomKeyStore(_a0 *kms.DeleteCustomKeyStoreInput) (*kms.DeleteCustomKeyStoreOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.DeleteImportedKeyMaterialOutput
-	if rf, ok := ret.Get(0).(func(*kms.DeleteImportedKeyMaterialInput) *kms.DeleteImportedKeyMaterialOutput); ok {
+	var r0 *kms.DeleteCustomKeyStoreOutput
+	if rf, ok := ret.Get(0).(func(*kms.DeleteCustomKeyStoreInput) *kms.DeleteCustomKeyStoreOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.DeleteImportedKeyMaterialOutput)
+			r0 = ret.Get(0).(*kms.DeleteCustomKeyStoreOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.DeleteImportedKeyMaterialInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.DeleteCustomKeyStoreInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -503,12 +659,12 @@ func (_m *KMSAPI) DeleteImportedKeyMaterial(_a0 *kms.DeleteImportedKeyMaterialIn
 	return r0, r1
 }
 
-// DeleteImportedKeyMaterialRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) DeleteImportedKeyMaterialRequest(_a0 *kms.DeleteImportedKeyMaterialInput) (*request.Request, *kms.DeleteImportedKeyMaterialOutput) {
+// DeleteCustomKeyStoreRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) DeleteCustomKeyStoreRequest(_a0 *kms.DeleteCustomKeyStoreInput) (*request.Request, *kms.DeleteCustomKeyStoreOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.DeleteImportedKeyMaterialInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.DeleteCustomKeyStoreInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -516,20 +672,20 @@ func (_m *KMSAPI) DeleteImportedKeyMaterialRequest(_a0 *kms.DeleteImportedKeyMat
 		}
 	}
 
-	var r1 *kms.DeleteImportedKeyMaterialOutput
-	if rf, ok := ret.Get(1).(func(*kms.DeleteImportedKeyMaterialInput) *kms.DeleteImportedKeyMaterialOutput); ok {
+	var r1 *kms.DeleteCustomKeyStoreOutput
+	if rf, ok := ret.Get(1).(func(*kms.DeleteCustomKeyStoreInput) *kms.DeleteCustomKeyStoreOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.DeleteImportedKeyMaterialOutput)
+			r1 = ret.Get(1).(*kms.DeleteCustomKeyStoreOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DeleteImportedKeyMaterialWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) DeleteImportedKeyMaterialWithContext(_a0 aws.Context, _a1 *kms.DeleteImportedKeyMaterialInput, _a2 ...request.Option) (*kms.DeleteImportedKeyMaterialOutput, error) {
+// DeleteCustomKeyStoreWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) DeleteCustomKeyStoreWithContext(_a0 aws.Context, _a1 *kms.DeleteCustomKeyStoreInput, _a2 ...request.Option) (*kms.DeleteCustomKeyStoreOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -539,17 +695,17 @@ func (_m *KMSAPI) DeleteImportedKeyMaterialWithContext(_a0 aws.Context, _a1 *kms
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.DeleteImportedKeyMaterialOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.DeleteImportedKeyMaterialInput, ...request.Option) *kms.DeleteImportedKeyMaterialOutput); ok {
+	var r0 *kms.DeleteCustomKeyStoreOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.DeleteCustomKeyStoreInput, ...request.Option) *kms.DeleteCustomKeyStoreOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.DeleteImportedKeyMaterialOutput)
+			r0 = ret.Get(0).(*kms.DeleteCustomKeyStoreOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.DeleteImportedKeyMaterialInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.DeleteCustomKeyStoreInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -558,21 +714,21 @@ func (_m *KMSAPI) DeleteImportedKeyMaterialWithContext(_a0 aws.Context, _a1 *kms
 	return r0, r1
 }
 
-// DescribeKey provides a mock function with given fields: _a0
-func (_m *KMSAPI) DescribeKey(_a0 *kms.DescribeKeyInput) (*kms.DescribeKeyOutput, error) {
+// DeleteImportedKeyMaterial provides a mock function with given fields: _a0
+func (_m *KMSAPI) DeleteImportedKeyMaterial(_a0 *kms.DeleteImportedKeyMaterialInput) (*kms.DeleteImportedKeyMaterialOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.DescribeKeyOutput
-	if rf, ok := ret.Get(0).(func(*kms.DescribeKeyInput) *kms.DescribeKeyOutput); ok {
+	var r0 *kms.DeleteImportedKeyMaterialOutput
+	if rf, ok := ret.Get(0).(func(*kms.DeleteImportedKeyMaterialInput) *kms.DeleteImportedKeyMaterialOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.DescribeKeyOutput)
+			r0 = ret.Get(0).(*kms.DeleteImportedKeyMaterialOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.DescribeKeyInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.DeleteImportedKeyMaterialInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -581,12 +737,12 @@ func (_m *KMSAPI) DescribeKey(_a0 *kms.DescribeKeyInput) (*kms.DescribeKeyOutput
 	return r0, r1
 }
 
-// DescribeKeyRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) DescribeKeyRequest(_a0 *kms.DescribeKeyInput) (*request.Request, *kms.DescribeKeyOutput) {
+// DeleteImportedKeyMaterialRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) DeleteImportedKeyMaterialRequest(_a0 *kms.DeleteImportedKeyMaterialInput) (*request.Request, *kms.DeleteImportedKeyMaterialOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.DescribeKeyInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.DeleteImportedKeyMaterialInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -594,20 +750,20 @@ func (_m *KMSAPI) DescribeKeyRequest(_a0 *kms.DescribeKeyInput) (*request.Reques
 		}
 	}
 
-	var r1 *kms.DescribeKeyOutput
-	if rf, ok := ret.Get(1).(func(*kms.DescribeKeyInput) *kms.DescribeKeyOutput); ok {
+	var r1 *kms.DeleteImportedKeyMaterialOutput
+	if rf, ok := ret.Get(1).(func(*kms.DeleteImportedKeyMaterialInput) *kms.DeleteImportedKeyMaterialOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.DescribeKeyOutput)
+			r1 = ret.Get(1).(*kms.DeleteImportedKeyMaterialOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeKeyWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) DescribeKeyWithContext(_a0 aws.Context, _a1 *kms.DescribeKeyInput, _a2 ...request.Option) (*kms.DescribeKeyOutput, error) {
+// DeleteImportedKeyMaterialWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) DeleteImportedKeyMaterialWithContext(_a0 aws.Context, _a1 *kms.DeleteImportedKeyMaterialInput, _a2 ...request.Option) (*kms.DeleteImportedKeyMaterialOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -617,17 +773,17 @@ func (_m *KMSAPI) DescribeKeyWithContext(_a0 aws.Context, _a1 *kms.DescribeKeyIn
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.DescribeKeyOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.DescribeKeyInput, ...request.Option) *kms.DescribeKeyOutput); ok {
+	var r0 *kms.DeleteImportedKeyMaterialOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.DeleteImportedKeyMaterialInput, ...request.Option) *kms.DeleteImportedKeyMaterialOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.DescribeKeyOutput)
+			r0 = ret.Get(0).(*kms.DeleteImportedKeyMaterialOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.DescribeKeyInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.DeleteImportedKeyMaterialInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -636,21 +792,21 @@ func (_m *KMSAPI) DescribeKeyWithContext(_a0 aws.Context, _a1 *kms.DescribeKeyIn
 	return r0, r1
 }
 
-// DisableKey provides a mock function with given fields: _a0
-func (_m *KMSAPI) DisableKey(_a0 *kms.DisableKeyInput) (*kms.DisableKeyOutput, error) {
+// DeriveSharedSecret provides a mock function with given fields: _a0
+func (_m *KMSAPI) DeriveSharedSecret(_a0 *kms.DeriveSharedSecretInput) (*kms.DeriveSharedSecretOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.DisableKeyOutput
-	if rf, ok := ret.Get(0).(func(*kms.DisableKeyInput) *kms.DisableKeyOutput); ok {
+	var r0 *kms.DeriveSharedSecretOutput
+	if rf, ok := ret.Get(0).(func(*kms.DeriveSharedSecretInput) *kms.DeriveSharedSecretOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.DisableKeyOutput)
+			r0 = ret.Get(0).(*kms.DeriveSharedSecretOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.DisableKeyInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.DeriveSharedSecretInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -659,12 +815,12 @@ func (_m *KMSAPI) DisableKey(_a0 *kms.DisableKeyInput) (*kms.DisableKeyOutput, e
 	return r0, r1
 }
 
-// DisableKeyRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) DisableKeyRequest(_a0 *kms.DisableKeyInput) (*request.Request, *kms.DisableKeyOutput) {
+// DeriveSharedSecretRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) DeriveSharedSecretRequest(_a0 *kms.DeriveSharedSecretInput) (*request.Request, *kms.DeriveSharedSecretOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.DisableKeyInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.DeriveSharedSecretInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -672,33 +828,63 @@ func (_m *KMSAPI) DisableKeyRequest(_a0 *kms.DisableKeyInput) (*request.Request,
 		}
 	}
 
-	var r1 *kms.DisableKeyOutput
-	if rf, ok := ret.Get(1).(func(*kms.DisableKeyInput) *kms.DisableKeyOutput); ok {
+	var r1 *kms.DeriveSharedSecretOutput
+	if rf, ok := ret.Get(1).(func(*kms.DeriveSharedSecretInput) *kms.DeriveSharedSecretOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.DisableKeyOutput)
+			r1 = ret.Get(1).(*kms.DeriveSharedSecretOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DisableKeyRotation provides a mock function with given fields: _a0
-func (_m *KMSAPI) DisableKeyRotation(_a0 *kms.DisableKeyRotationInput) (*kms.DisableKeyRotationOutput, error) {
+// DeriveSharedSecretWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) DeriveSharedSecretWithContext(_a0 aws.Context, _a1 *kms.DeriveSharedSecretInput, _a2 ...request.Option) (*kms.DeriveSharedSecretOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *kms.DeriveSharedSecretOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.DeriveSharedSecretInput, ...request.Option) *kms.DeriveSharedSecretOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.DeriveSharedSecretOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.DeriveSharedSecretInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeCustomKeyStores provides a mock function with given fields: _a0
+func (_m *KMSAPI) DescribeCustomKeyStores(_a0 *kms.DescribeCustomKeyStoresInput) (*kms.DescribeCustomKeyStoresOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.DisableKeyRotationOutput
-	if rf, ok := ret.Get(0).(func(*kms.DisableKeyRotationInput) *kms.DisableKeyRotationOutput); ok {
+	var r0 *kms.DescribeCustomKeyStoresOutput
+	if rf, ok := ret.Get(0).(func(*kms.DescribeCustomKeyStoresInput) *kms.DescribeCustomKeyStoresOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.DisableKeyRotationOutput)
+			r0 = ret.Get(0).(*kms.DescribeCustomKeyStoresOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.DisableKeyRotationInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.DescribeCustomKeyStoresInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -707,12 +893,47 @@ func (_m *KMSAPI) DisableKeyRotation(_a0 *kms.DisableKeyRotationInput) (*kms.Dis
 	return r0, r1
 }
 
-// DisableKeyRotationRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) DisableKeyRotationRequest(_a0 *kms.DisableKeyRotationInput) (*request.Request, *kms.DisableKeyRotationOutput) {
+// DescribeCustomKeyStoresPages provides a mock function with given fields: _a0, _a1
+func (_m *KMSAPI) DescribeCustomKeyStoresPages(_a0 *kms.DescribeCustomKeyStoresInput, _a1 func(*kms.DescribeCustomKeyStoresOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*kms.DescribeCustomKeyStoresInput, func(*kms.DescribeCustomKeyStoresOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeCustomKeyStoresPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *KMSAPI) DescribeCustomKeyStoresPagesWithContext(_a0 aws.Context, _a1 *kms.DescribeCustomKeyStoresInput, _a2 func(*kms.DescribeCustomKeyStoresOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.DescribeCustomKeyStoresInput, func(*kms.DescribeCustomKeyStoresOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeCustomKeyStoresRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) DescribeCustomKeyStoresRequest(_a0 *kms.DescribeCustomKeyStoresInput) (*request.Request, *kms.DescribeCustomKeyStoresOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.DisableKeyRotationInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.DescribeCustomKeyStoresInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -720,20 +941,20 @@ func (_m *KMSAPI) DisableKeyRotationRequest(_a0 *kms.DisableKeyRotationInput) (*
 		}
 	}
 
-	var r1 *kms.DisableKeyRotationOutput
-	if rf, ok := ret.Get(1).(func(*kms.DisableKeyRotationInput) *kms.DisableKeyRotationOutput); ok {
+	var r1 *kms.DescribeCustomKeyStoresOutput
+	if rf, ok := ret.Get(1).(func(*kms.DescribeCustomKeyStoresInput) *kms.DescribeCustomKeyStoresOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.DisableKeyRotationOutput)
+			r1 = ret.Get(1).(*kms.DescribeCustomKeyStoresOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DisableKeyRotationWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) DisableKeyRotationWithContext(_a0 aws.Context, _a1 *kms.DisableKeyRotationInput, _a2 ...request.Option) (*kms.DisableKeyRotationOutput, error) {
+// DescribeCustomKeyStoresWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) DescribeCustomKeyStoresWithContext(_a0 aws.Context, _a1 *kms.DescribeCustomKeyStoresInput, _a2 ...request.Option) (*kms.DescribeCustomKeyStoresOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -743,17 +964,17 @@ func (_m *KMSAPI) DisableKeyRotationWithContext(_a0 aws.Context, _a1 *kms.Disabl
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.DisableKeyRotationOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.DisableKeyRotationInput, ...request.Option) *kms.DisableKeyRotationOutput); ok {
+	var r0 *kms.DescribeCustomKeyStoresOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.DescribeCustomKeyStoresInput, ...request.Option) *kms.DescribeCustomKeyStoresOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.DisableKeyRotationOutput)
+			r0 = ret.Get(0).(*kms.DescribeCustomKeyStoresOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.DisableKeyRotationInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.DescribeCustomKeyStoresInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -762,8 +983,56 @@ func (_m *KMSAPI) DisableKeyRotationWithContext(_a0 aws.Context, _a1 *kms.Disabl
 	return r0, r1
 }
 
-// DisableKeyWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) DisableKeyWithContext(_a0 aws.Context, _a1 *kms.DisableKeyInput, _a2 ...request.Option) (*kms.DisableKeyOutput, error) {
+// DescribeKey provides a mock function with given fields: _a0
+func (_m *KMSAPI) DescribeKey(_a0 *kms.DescribeKeyInput) (*kms.DescribeKeyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *kms.DescribeKeyOutput
+	if rf, ok := ret.Get(0).(func(*kms.DescribeKeyInput) *kms.DescribeKeyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.DescribeKeyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*kms.DescribeKeyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeKeyRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) DescribeKeyRequest(_a0 *kms.DescribeKeyInput) (*request.Request, *kms.DescribeKeyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*kms.DescribeKeyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *kms.DescribeKeyOutput
+	if rf, ok := ret.Get(1).(func(*kms.DescribeKeyInput) *kms.DescribeKeyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*kms.DescribeKeyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeKeyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) DescribeKeyWithContext(_a0 aws.Context, _a1 *kms.DescribeKeyInput, _a2 ...request.Option) (*kms.DescribeKeyOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -773,17 +1042,17 @@ func (_m *KMSAPI) DisableKeyWithContext(_a0 aws.Context, _a1 *kms.DisableKeyInpu
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.DisableKeyOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.DisableKeyInput, ...request.Option) *kms.DisableKeyOutput); ok {
+	var r0 *kms.DescribeKeyOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.DescribeKeyInput, ...request.Option) *kms.DescribeKeyOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.DisableKeyOutput)
+			r0 = ret.Get(0).(*kms.DescribeKeyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.DisableKeyInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.DescribeKeyInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -792,21 +1061,21 @@ func (_m *KMSAPI) DisableKeyWithContext(_a0 aws.Context, _a1 *kms.DisableKeyInpu
 	return r0, r1
 }
 
-// EnableKey provides a mock function with given fields: _a0
-func (_m *KMSAPI) EnableKey(_a0 *kms.EnableKeyInput) (*kms.EnableKeyOutput, error) {
+// DisableKey provides a mock function with given fields: _a0
+func (_m *KMSAPI) DisableKey(_a0 *kms.DisableKeyInput) (*kms.DisableKeyOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.EnableKeyOutput
-	if rf, ok := ret.Get(0).(func(*kms.EnableKeyInput) *kms.EnableKeyOutput); ok {
+	var r0 *kms.DisableKeyOutput
+	if rf, ok := ret.Get(0).(func(*kms.DisableKeyInput) *kms.DisableKeyOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.EnableKeyOutput)
+			r0 = ret.Get(0).(*kms.DisableKeyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.EnableKeyInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.DisableKeyInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -815,12 +1084,12 @@ func (_m *KMSAPI) EnableKey(_a0 *kms.EnableKeyInput) (*kms.EnableKeyOutput, erro
 	return r0, r1
 }
 
-// EnableKeyRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) EnableKeyRequest(_a0 *kms.EnableKeyInput) (*request.Request, *kms.EnableKeyOutput) {
+// DisableKeyRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) DisableKeyRequest(_a0 *kms.DisableKeyInput) (*request.Request, *kms.DisableKeyOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.EnableKeyInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.DisableKeyInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -828,33 +1097,33 @@ func (_m *KMSAPI) EnableKeyRequest(_a0 *kms.EnableKeyInput) (*request.Request, *
 		}
 	}
 
-	var r1 *kms.EnableKeyOutput
-	if rf, ok := ret.Get(1).(func(*kms.EnableKeyInput) *kms.EnableKeyOutput); ok {
+	var r1 *kms.DisableKeyOutput
+	if rf, ok := ret.Get(1).(func(*kms.DisableKeyInput) *kms.DisableKeyOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.EnableKeyOutput)
+			r1 = ret.Get(1).(*kms.DisableKeyOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// EnableKeyRotation provides a mock function with given fields: _a0
-func (_m *KMSAPI) EnableKeyRotation(_a0 *kms.EnableKeyRotationInput) (*kms.EnableKeyRotationOutput, error) {
+// DisableKeyRotation provides a mock function with given fields: _a0
+func (_m *KMSAPI) DisableKeyRotation(_a0 *kms.DisableKeyRotationInput) (*kms.DisableKeyRotationOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.EnableKeyRotationOutput
-	if rf, ok := ret.Get(0).(func(*kms.EnableKeyRotationInput) *kms.EnableKeyRotationOutput); ok {
+	var r0 *kms.DisableKeyRotationOutput
+	if rf, ok := ret.Get(0).(func(*kms.DisableKeyRotationInput) *kms.DisableKeyRotationOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.EnableKeyRotationOutput)
+			r0 = ret.Get(0).(*kms.DisableKeyRotationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.EnableKeyRotationInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.DisableKeyRotationInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -863,12 +1132,12 @@ func (_m *KMSAPI) EnableKeyRotation(_a0 *kms.EnableKeyRotationInput) (*kms.Enabl
 	return r0, r1
 }
 
-// EnableKeyRotationRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) EnableKeyRotationRequest(_a0 *kms.EnableKeyRotationInput) (*request.Request, *kms.EnableKeyRotationOutput) {
+// DisableKeyRotationRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) DisableKeyRotationRequest(_a0 *kms.DisableKeyRotationInput) (*request.Request, *kms.DisableKeyRotationOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.EnableKeyRotationInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.DisableKeyRotationInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -876,20 +1145,20 @@ func (_m *KMSAPI) EnableKeyRotationRequest(_a0 *kms.EnableKeyRotationInput) (*re
 		}
 	}
 
-	var r1 *kms.EnableKeyRotationOutput
-	if rf, ok := ret.Get(1).(func(*kms.EnableKeyRotationInput) *kms.EnableKeyRotationOutput); ok {
+	var r1 *kms.DisableKeyRotationOutput
+	if rf, ok := ret.Get(1).(func(*kms.DisableKeyRotationInput) *kms.DisableKeyRotationOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.EnableKeyRotationOutput)
+			r1 = ret.Get(1).(*kms.DisableKeyRotationOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// EnableKeyRotationWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) EnableKeyRotationWithContext(_a0 aws.Context, _a1 *kms.EnableKeyRotationInput, _a2 ...request.Option) (*kms.EnableKeyRotationOutput, error) {
+// DisableKeyRotationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) DisableKeyRotationWithContext(_a0 aws.Context, _a1 *kms.DisableKeyRotationInput, _a2 ...request.Option) (*kms.DisableKeyRotationOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -899,17 +1168,17 @@ func (_m *KMSAPI) EnableKeyRotationWithContext(_a0 aws.Context, _a1 *kms.EnableK
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.EnableKeyRotationOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.EnableKeyRotationInput, ...request.Option) *kms.EnableKeyRotationOutput); ok {
+	var r0 *kms.DisableKeyRotationOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.DisableKeyRotationInput, ...request.Option) *kms.DisableKeyRotationOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.EnableKeyRotationOutput)
+			r0 = ret.Get(0).(*kms.DisableKeyRotationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.EnableKeyRotationInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.DisableKeyRotationInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -918,8 +1187,8 @@ func (_m *KMSAPI) EnableKeyRotationWithContext(_a0 aws.Context, _a1 *kms.EnableK
 	return r0, r1
 }
 
-// EnableKeyWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) EnableKeyWithContext(_a0 aws.Context, _a1 *kms.EnableKeyInput, _a2 ...request.Option) (*kms.EnableKeyOutput, error) {
+// DisableKeyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) DisableKeyWithContext(_a0 aws.Context, _a1 *kms.DisableKeyInput, _a2 ...request.Option) (*kms.DisableKeyOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -929,17 +1198,17 @@ func (_m *KMSAPI) EnableKeyWithContext(_a0 aws.Context, _a1 *kms.EnableKeyInput,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.EnableKeyOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.EnableKeyInput, ...request.Option) *kms.EnableKeyOutput); ok {
+	var r0 *kms.DisableKeyOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.DisableKeyInput, ...request.Option) *kms.DisableKeyOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.EnableKeyOutput)
+			r0 = ret.Get(0).(*kms.DisableKeyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.EnableKeyInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.DisableKeyInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -948,21 +1217,21 @@ func (_m *KMSAPI) EnableKeyWithContext(_a0 aws.Context, _a1 *kms.EnableKeyInput,
 	return r0, r1
 }
 
-// Encrypt provides a mock function with given fields: _a0
-func (_m *KMSAPI) Encrypt(_a0 *kms.EncryptInput) (*kms.EncryptOutput, error) {
+// DisconnectCustomKeyStore provides a mock function with given fields: _a0
+func (_m *KMSAPI) DisconnectCustomKeyStore(_a0 *kms.DisconnectCustomKeyStoreInput) (*kms.DisconnectCustomKeyStoreOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.EncryptOutput
-	if rf, ok := ret.Get(0).(func(*kms.EncryptInput) *kms.EncryptOutput); ok {
+	var r0 *kms.DisconnectCustomKeyStoreOutput
+	if rf, ok := ret.Get(0).(func(*kms.DisconnectCustomKeyStoreInput) *kms.DisconnectCustomKeyStoreOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.EncryptOutput)
+			r0 = ret.Get(0).(*kms.DisconnectCustomKeyStoreOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.EncryptInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.DisconnectCustomKeyStoreInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -971,12 +1240,12 @@ func (_m *KMSAPI) Encrypt(_a0 *kms.EncryptInput) (*kms.EncryptOutput, error) {
 	return r0, r1
 }
 
-// EncryptRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) EncryptRequest(_a0 *kms.EncryptInput) (*request.Request, *kms.EncryptOutput) {
+// DisconnectCustomKeyStoreRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) DisconnectCustomKeyStoreRequest(_a0 *kms.DisconnectCustomKeyStoreInput) (*request.Request, *kms.DisconnectCustomKeyStoreOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.EncryptInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.DisconnectCustomKeyStoreInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -984,20 +1253,20 @@ func (_m *KMSAPI) EncryptRequest(_a0 *kms.EncryptInput) (*request.Request, *kms.
 		}
 	}
 
-	var r1 *kms.EncryptOutput
-	if rf, ok := ret.Get(1).(func(*kms.EncryptInput) *kms.EncryptOutput); ok {
+	var r1 *kms.DisconnectCustomKeyStoreOutput
+	if rf, ok := ret.Get(1).(func(*kms.DisconnectCustomKeyStoreInput) *kms.DisconnectCustomKeyStoreOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.EncryptOutput)
+			r1 = ret.Get(1).(*kms.DisconnectCustomKeyStoreOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// EncryptWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) EncryptWithContext(_a0 aws.Context, _a1 *kms.EncryptInput, _a2 ...request.Option) (*kms.EncryptOutput, error) {
+// DisconnectCustomKeyStoreWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) DisconnectCustomKeyStoreWithContext(_a0 aws.Context, _a1 *kms.DisconnectCustomKeyStoreInput, _a2 ...request.Option) (*kms.DisconnectCustomKeyStoreOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1007,17 +1276,17 @@ func (_m *KMSAPI) EncryptWithContext(_a0 aws.Context, _a1 *kms.EncryptInput, _a2
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.EncryptOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.EncryptInput, ...request.Option) *kms.EncryptOutput); ok {
+	var r0 *kms.DisconnectCustomKeyStoreOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.DisconnectCustomKeyStoreInput, ...request.Option) *kms.DisconnectCustomKeyStoreOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.EncryptOutput)
+			r0 = ret.Get(0).(*kms.DisconnectCustomKeyStoreOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.EncryptInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.DisconnectCustomKeyStoreInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1026,21 +1295,21 @@ func (_m *KMSAPI) EncryptWithContext(_a0 aws.Context, _a1 *kms.EncryptInput, _a2
 	return r0, r1
 }
 
-// GenerateDataKey provides a mock function with given fields: _a0
-func (_m *KMSAPI) GenerateDataKey(_a0 *kms.GenerateDataKeyInput) (*kms.GenerateDataKeyOutput, error) {
+// EnableKey provides a mock function with given fields: _a0
+func (_m *KMSAPI) EnableKey(_a0 *kms.EnableKeyInput) (*kms.EnableKeyOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.GenerateDataKeyOutput
-	if rf, ok := ret.Get(0).(func(*kms.GenerateDataKeyInput) *kms.GenerateDataKeyOutput); ok {
+	var r0 *kms.EnableKeyOutput
+	if rf, ok := ret.Get(0).(func(*kms.EnableKeyInput) *kms.EnableKeyOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.GenerateDataKeyOutput)
+			r0 = ret.Get(0).(*kms.EnableKeyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.GenerateDataKeyInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.EnableKeyInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1049,12 +1318,12 @@ func (_m *KMSAPI) GenerateDataKey(_a0 *kms.GenerateDataKeyInput) (*kms.GenerateD
 	return r0, r1
 }
 
-// GenerateDataKeyRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) GenerateDataKeyRequest(_a0 *kms.GenerateDataKeyInput) (*request.Request, *kms.GenerateDataKeyOutput) {
+// EnableKeyRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) EnableKeyRequest(_a0 *kms.EnableKeyInput) (*request.Request, *kms.EnableKeyOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.GenerateDataKeyInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.EnableKeyInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1062,63 +1331,33 @@ func (_m *KMSAPI) GenerateDataKeyRequest(_a0 *kms.GenerateDataKeyInput) (*reques
 		}
 	}
 
-	var r1 *kms.GenerateDataKeyOutput
-	if rf, ok := ret.Get(1).(func(*kms.GenerateDataKeyInput) *kms.GenerateDataKeyOutput); ok {
+	var r1 *kms.EnableKeyOutput
+	if rf, ok := ret.Get(1).(func(*kms.EnableKeyInput) *kms.EnableKeyOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.GenerateDataKeyOutput)
-		}
-	}
-
-	return r0, r1
-}
-
-// GenerateDataKeyWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) GenerateDataKeyWithContext(_a0 aws.Context, _a1 *kms.GenerateDataKeyInput, _a2 ...request.Option) (*kms.GenerateDataKeyOutput, error) {
-	_va := make([]interface{}, len(_a2))
-	for _i := range _a2 {
-		_va[_i] = _a2[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 *kms.GenerateDataKeyOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.GenerateDataKeyInput, ...request.Option) *kms.GenerateDataKeyOutput); ok {
-		r0 = rf(_a0, _a1, _a2...)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.GenerateDataKeyOutput)
+			r1 = ret.Get(1).(*kms.EnableKeyOutput)
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.GenerateDataKeyInput, ...request.Option) error); ok {
-		r1 = rf(_a0, _a1, _a2...)
-	} else {
-		r1 = ret.Error(1)
-	}
-
 	return r0, r1
 }
 
-// GenerateDataKeyWithoutPlaintext provides a mock function with given fields: _a0
-func (_m *KMSAPI) GenerateDataKeyWithoutPlaintext(_a0 *kms.GenerateDataKeyWithoutPlaintextInput) (*kms.GenerateDataKeyWithoutPlaintextOutput, error) {
+// EnableKeyRotation provides a mock function with given fields: _a0
+func (_m *KMSAPI) EnableKeyRotation(_a0 *kms.EnableKeyRotationInput) (*kms.EnableKeyRotationOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.GenerateDataKeyWithoutPlaintextOutput
-	if rf, ok := ret.Get(0).(func(*kms.GenerateDataKeyWithoutPlaintextInput) *kms.GenerateDataKeyWithoutPlaintextOutput); ok {
+	var r0 *kms.EnableKeyRotationOutput
+	if rf, ok := ret.Get(0).(func(*kms.EnableKeyRotationInput) *kms.EnableKeyRotationOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.GenerateDataKeyWithoutPlaintextOutput)
+			r0 = ret.Get(0).(*kms.EnableKeyRotationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.GenerateDataKeyWithoutPlaintextInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.EnableKeyRotationInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1127,12 +1366,12 @@ func (_m *KMSAPI) GenerateDataKeyWithoutPlaintext(_a0 *kms.GenerateDataKeyWithou
 	return r0, r1
 }
 
-// GenerateDataKeyWithoutPlaintextRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) GenerateDataKeyWithoutPlaintextRequest(_a0 *kms.GenerateDataKeyWithoutPlaintextInput) (*request.Request, *kms.GenerateDataKeyWithoutPlaintextOutput) {
+// EnableKeyRotationRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) EnableKeyRotationRequest(_a0 *kms.EnableKeyRotationInput) (*request.Request, *kms.EnableKeyRotationOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.GenerateDataKeyWithoutPlaintextInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.EnableKeyRotationInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1140,20 +1379,20 @@ func (_m *KMSAPI) GenerateDataKeyWithoutPlaintextRequest(_a0 *kms.GenerateDataKe
 		}
 	}
 
-	var r1 *kms.GenerateDataKeyWithoutPlaintextOutput
-	if rf, ok := ret.Get(1).(func(*kms.GenerateDataKeyWithoutPlaintextInput) *kms.GenerateDataKeyWithoutPlaintextOutput); ok {
+	var r1 *kms.EnableKeyRotationOutput
+	if rf, ok := ret.Get(1).(func(*kms.EnableKeyRotationInput) *kms.EnableKeyRotationOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.GenerateDataKeyWithoutPlaintextOutput)
+			r1 = ret.Get(1).(*kms.EnableKeyRotationOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// GenerateDataKeyWithoutPlaintextWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) GenerateDataKeyWithoutPlaintextWithContext(_a0 aws.Context, _a1 *kms.GenerateDataKeyWithoutPlaintextInput, _a2 ...request.Option) (*kms.GenerateDataKeyWithoutPlaintextOutput, error) {
+// EnableKeyRotationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) EnableKeyRotationWithContext(_a0 aws.Context, _a1 *kms.EnableKeyRotationInput, _a2 ...request.Option) (*kms.EnableKeyRotationOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1163,17 +1402,17 @@ func (_m *KMSAPI) GenerateDataKeyWithoutPlaintextWithContext(_a0 aws.Context, _a
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.GenerateDataKeyWithoutPlaintextOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.GenerateDataKeyWithoutPlaintextInput, ...request.Option) *kms.GenerateDataKeyWithoutPlaintextOutput); ok {
+	var r0 *kms.EnableKeyRotationOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.EnableKeyRotationInput, ...request.Option) *kms.EnableKeyRotationOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.GenerateDataKeyWithoutPlaintextOutput)
+			r0 = ret.Get(0).(*kms.EnableKeyRotationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.GenerateDataKeyWithoutPlaintextInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.EnableKeyRotationInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1182,21 +1421,51 @@ func (_m *KMSAPI) GenerateDataKeyWithoutPlaintextWithContext(_a0 aws.Context, _a
 	return r0, r1
 }
 
-// GenerateRandom provides a mock function with given fields: _a0
-func (_m *KMSAPI) GenerateRandom(_a0 *kms.GenerateRandomInput) (*kms.GenerateRandomOutput, error) {
-	ret := _m.Called(_a0)
+// EnableKeyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) EnableKeyWithContext(_a0 aws.Context, _a1 *kms.EnableKeyInput, _a2 ...request.Option) (*kms.EnableKeyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
-	var r0 *kms.GenerateRandomOutput
-	if rf, ok := ret.Get(0).(func(*kms.GenerateRandomInput) *kms.GenerateRandomOutput); ok {
+	var r0 *kms.EnableKeyOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.EnableKeyInput, ...request.Option) *kms.EnableKeyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.EnableKeyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.EnableKeyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Encrypt provides a mock function with given fields: _a0
+func (_m *KMSAPI) Encrypt(_a0 *kms.EncryptInput) (*kms.EncryptOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *kms.EncryptOutput
+	if rf, ok := ret.Get(0).(func(*kms.EncryptInput) *kms.EncryptOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.GenerateRandomOutput)
+			r0 = ret.Get(0).(*kms.EncryptOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.GenerateRandomInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.EncryptInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1205,12 +1474,12 @@ func (_m *KMSAPI) GenerateRandom(_a0 *kms.GenerateRandomInput) (*kms.GenerateRan
 	return r0, r1
 }
 
-// GenerateRandomRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) GenerateRandomRequest(_a0 *kms.GenerateRandomInput) (*request.Request, *kms.GenerateRandomOutput) {
+// EncryptRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) EncryptRequest(_a0 *kms.EncryptInput) (*request.Request, *kms.EncryptOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.GenerateRandomInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.EncryptInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1218,20 +1487,20 @@ func (_m *KMSAPI) GenerateRandomRequest(_a0 *kms.GenerateRandomInput) (*request.
 		}
 	}
 
-	var r1 *kms.GenerateRandomOutput
-	if rf, ok := ret.Get(1).(func(*kms.GenerateRandomInput) *kms.GenerateRandomOutput); ok {
+	var r1 *kms.EncryptOutput
+	if rf, ok := ret.Get(1).(func(*kms.EncryptInput) *kms.EncryptOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.GenerateRandomOutput)
+			r1 = ret.Get(1).(*kms.EncryptOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// GenerateRandomWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) GenerateRandomWithContext(_a0 aws.Context, _a1 *kms.GenerateRandomInput, _a2 ...request.Option) (*kms.GenerateRandomOutput, error) {
+// EncryptWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) EncryptWithContext(_a0 aws.Context, _a1 *kms.EncryptInput, _a2 ...request.Option) (*kms.EncryptOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1241,17 +1510,17 @@ func (_m *KMSAPI) GenerateRandomWithContext(_a0 aws.Context, _a1 *kms.GenerateRa
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.GenerateRandomOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.GenerateRandomInput, ...request.Option) *kms.GenerateRandomOutput); ok {
+	var r0 *kms.EncryptOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.EncryptInput, ...request.Option) *kms.EncryptOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.GenerateRandomOutput)
+			r0 = ret.Get(0).(*kms.EncryptOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.GenerateRandomInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.EncryptInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1260,21 +1529,21 @@ func (_m *KMSAPI) GenerateRandomWithContext(_a0 aws.Context, _a1 *kms.GenerateRa
 	return r0, r1
 }
 
-// GetKeyPolicy provides a mock function with given fields: _a0
-func (_m *KMSAPI) GetKeyPolicy(_a0 *kms.GetKeyPolicyInput) (*kms.GetKeyPolicyOutput, error) {
+// GenerateDataKey provides a mock function with given fields: _a0
+func (_m *KMSAPI) GenerateDataKey(_a0 *kms.GenerateDataKeyInput) (*kms.GenerateDataKeyOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.GetKeyPolicyOutput
-	if rf, ok := ret.Get(0).(func(*kms.GetKeyPolicyInput) *kms.GetKeyPolicyOutput); ok {
+	var r0 *kms.GenerateDataKeyOutput
+	if rf, ok := ret.Get(0).(func(*kms.GenerateDataKeyInput) *kms.GenerateDataKeyOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.GetKeyPolicyOutput)
+			r0 = ret.Get(0).(*kms.GenerateDataKeyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.GetKeyPolicyInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.GenerateDataKeyInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1283,12 +1552,35 @@ func (_m *KMSAPI) GetKeyPolicy(_a0 *kms.GetKeyPolicyInput) (*kms.GetKeyPolicyOut
 	return r0, r1
 }
 
-// GetKeyPolicyRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) GetKeyPolicyRequest(_a0 *kms.GetKeyPolicyInput) (*request.Request, *kms.GetKeyPolicyOutput) {
+// GenerateDataKeyPair provides a mock function with given fields: _a0
+func (_m *KMSAPI) GenerateDataKeyPair(_a0 *kms.GenerateDataKeyPairInput) (*kms.GenerateDataKeyPairOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *kms.GenerateDataKeyPairOutput
+	if rf, ok := ret.Get(0).(func(*kms.GenerateDataKeyPairInput) *kms.GenerateDataKeyPairOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.GenerateDataKeyPairOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*kms.GenerateDataKeyPairInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GenerateDataKeyPairRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) GenerateDataKeyPairRequest(_a0 *kms.GenerateDataKeyPairInput) (*request.Request, *kms.GenerateDataKeyPairOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.GetKeyPolicyInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.GenerateDataKeyPairInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1296,20 +1588,20 @@ func (_m *KMSAPI) GetKeyPolicyRequest(_a0 *kms.GetKeyPolicyInput) (*request.Requ
 		}
 	}
 
-	var r1 *kms.GetKeyPolicyOutput
-	if rf, ok := ret.Get(1).(func(*kms.GetKeyPolicyInput) *kms.GetKeyPolicyOutput); ok {
+	var r1 *kms.GenerateDataKeyPairOutput
+	if rf, ok := ret.Get(1).(func(*kms.GenerateDataKeyPairInput) *kms.GenerateDataKeyPairOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.GetKeyPolicyOutput)
+			r1 = ret.Get(1).(*kms.GenerateDataKeyPairOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// GetKeyPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) GetKeyPolicyWithContext(_a0 aws.Context, _a1 *kms.GetKeyPolicyInput, _a2 ...request.Option) (*kms.GetKeyPolicyOutput, error) {
+// GenerateDataKeyPairWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) GenerateDataKeyPairWithContext(_a0 aws.Context, _a1 *kms.GenerateDataKeyPairInput, _a2 ...request.Option) (*kms.GenerateDataKeyPairOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1319,17 +1611,17 @@ func (_m *KMSAPI) GetKeyPolicyWithContext(_a0 aws.Context, _a1 *kms.GetKeyPolicy
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.GetKeyPolicyOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.GetKeyPolicyInput, ...request.Option) *kms.GetKeyPolicyOutput); ok {
+	var r0 *kms.GenerateDataKeyPairOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.GenerateDataKeyPairInput, ...request.Option) *kms.GenerateDataKeyPairOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.GetKeyPolicyOutput)
+			r0 = ret.Get(0).(*kms.GenerateDataKeyPairOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.GetKeyPolicyInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.GenerateDataKeyPairInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1338,21 +1630,21 @@ func (_m *KMSAPI) GetKeyPolicyWithContext(_a0 aws.Context, _a1 *kms.GetKeyPolicy
 	return r0, r1
 }
 
-// GetKeyRotationStatus provides a mock function with given fields: _a0
-func (_m *KMSAPI) GetKeyRotationStatus(_a0 *kms.GetKeyRotationStatusInput) (*kms.GetKeyRotationStatusOutput, error) {
+// GenerateDataKeyPairWithoutPlaintext provides a mock function with given fields: _a0
+func (_m *KMSAPI) GenerateDataKeyPairWithoutPlaintext(_a0 *kms.GenerateDataKeyPairWithoutPlaintextInput) (*kms.GenerateDataKeyPairWithoutPlaintextOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.GetKeyRotationStatusOutput
-	if rf, ok := ret.Get(0).(func(*kms.GetKeyRotationStatusInput) *kms.GetKeyRotationStatusOutput); ok {
+	var r0 *kms.GenerateDataKeyPairWithoutPlaintextOutput
+	if rf, ok := ret.Get(0).(func(*kms.GenerateDataKeyPairWithoutPlaintextInput) *kms.GenerateDataKeyPairWithoutPlaintextOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.GetKeyRotationStatusOutput)
+			r0 = ret.Get(0).(*kms.GenerateDataKeyPairWithoutPlaintextOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.GetKeyRotationStatusInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.GenerateDataKeyPairWithoutPlaintextInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1361,12 +1653,12 @@ func (_m *KMSAPI) GetKeyRotationStatus(_a0 *kms.GetKeyRotationStatusInput) (*kms
 	return r0, r1
 }
 
-// GetKeyRotationStatusRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) GetKeyRotationStatusRequest(_a0 *kms.GetKeyRotationStatusInput) (*request.Request, *kms.GetKeyRotationStatusOutput) {
+// GenerateDataKeyPairWithoutPlaintextRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) GenerateDataKeyPairWithoutPlaintextRequest(_a0 *kms.GenerateDataKeyPairWithoutPlaintextInput) (*request.Request, *kms.GenerateDataKeyPairWithoutPlaintextOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.GetKeyRotationStatusInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.GenerateDataKeyPairWithoutPlaintextInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1374,20 +1666,20 @@ func (_m *KMSAPI) GetKeyRotationStatusRequest(_a0 *kms.GetKeyRotationStatusInput
 		}
 	}
 
-	var r1 *kms.GetKeyRotationStatusOutput
-	if rf, ok := ret.Get(1).(func(*kms.GetKeyRotationStatusInput) *kms.GetKeyRotationStatusOutput); ok {
+	var r1 *kms.GenerateDataKeyPairWithoutPlaintextOutput
+	if rf, ok := ret.Get(1).(func(*kms.GenerateDataKeyPairWithoutPlaintextInput) *kms.GenerateDataKeyPairWithoutPlaintextOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.GetKeyRotationStatusOutput)
+			r1 = ret.Get(1).(*kms.GenerateDataKeyPairWithoutPlaintextOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// GetKeyRotationStatusWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) GetKeyRotationStatusWithContext(_a0 aws.Context, _a1 *kms.GetKeyRotationStatusInput, _a2 ...request.Option) (*kms.GetKeyRotationStatusOutput, error) {
+// GenerateDataKeyPairWithoutPlaintextWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) GenerateDataKeyPairWithoutPlaintextWithContext(_a0 aws.Context, _a1 *kms.GenerateDataKeyPairWithoutPlaintextInput, _a2 ...request.Option) (*kms.GenerateDataKeyPairWithoutPlaintextOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1397,17 +1689,17 @@ func (_m *KMSAPI) GetKeyRotationStatusWithContext(_a0 aws.Context, _a1 *kms.GetK
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.GetKeyRotationStatusOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.GetKeyRotationStatusInput, ...request.Option) *kms.GetKeyRotationStatusOutput); ok {
+	var r0 *kms.GenerateDataKeyPairWithoutPlaintextOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.GenerateDataKeyPairWithoutPlaintextInput, ...request.Option) *kms.GenerateDataKeyPairWithoutPlaintextOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.GetKeyRotationStatusOutput)
+			r0 = ret.Get(0).(*kms.GenerateDataKeyPairWithoutPlaintextOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.GetKeyRotationStatusInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.GenerateDataKeyPairWithoutPlaintextInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1416,35 +1708,12 @@ func (_m *KMSAPI) GetKeyRotationStatusWithContext(_a0 aws.Context, _a1 *kms.GetK
 	return r0, r1
 }
 
-// GetParametersForImport provides a mock function with given fields: _a0
-func (_m *KMSAPI) GetParametersForImport(_a0 *kms.GetParametersForImportInput) (*kms.GetParametersForImportOutput, error) {
-	ret := _m.Called(_a0)
-
-	var r0 *kms.GetParametersForImportOutput
-	if rf, ok := ret.Get(0).(func(*kms.GetParametersForImportInput) *kms.GetParametersForImportOutput); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.GetParametersForImportOutput)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.GetParametersForImportInput) error); ok {
-		r1 = rf(_a0)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// GetParametersForImportRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) GetParametersForImportRequest(_a0 *kms.GetParametersForImportInput) (*request.Request, *kms.GetParametersForImportOutput) {
+// GenerateDataKeyRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) GenerateDataKeyRequest(_a0 *kms.GenerateDataKeyInput) (*request.Request, *kms.GenerateDataKeyOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.GetParametersForImportInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.GenerateDataKeyInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1452,20 +1721,20 @@ func (_m *KMSAPI) GetParametersForImportRequest(_a0 *kms.GetParametersForImportI
 		}
 	}
 
-	var r1 *kms.GetParametersForImportOutput
-	if rf, ok := ret.Get(1).(func(*kms.GetParametersForImportInput) *kms.GetParametersForImportOutput); ok {
+	var r1 *kms.GenerateDataKeyOutput
+	if rf, ok := ret.Get(1).(func(*kms.GenerateDataKeyInput) *kms.GenerateDataKeyOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.GetParametersForImportOutput)
+			r1 = ret.Get(1).(*kms.GenerateDataKeyOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// GetParametersForImportWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) GetParametersForImportWithContext(_a0 aws.Context, _a1 *kms.GetParametersForImportInput, _a2 ...request.Option) (*kms.GetParametersForImportOutput, error) {
+// GenerateDataKeyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) GenerateDataKeyWithContext(_a0 aws.Context, _a1 *kms.GenerateDataKeyInput, _a2 ...request.Option) (*kms.GenerateDataKeyOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1475,17 +1744,17 @@ func (_m *KMSAPI) GetParametersForImportWithContext(_a0 aws.Context, _a1 *kms.Ge
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.GetParametersForImportOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.GetParametersForImportInput, ...request.Option) *kms.GetParametersForImportOutput); ok {
+	var r0 *kms.GenerateDataKeyOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.GenerateDataKeyInput, ...request.Option) *kms.GenerateDataKeyOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.GetParametersForImportOutput)
+			r0 = ret.Get(0).(*kms.GenerateDataKeyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.GetParametersForImportInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.GenerateDataKeyInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1494,21 +1763,21 @@ func (_m *KMSAPI) GetParametersForImportWithContext(_a0 aws.Context, _a1 *kms.Ge
 	return r0, r1
 }
 
-// ImportKeyMaterial provides a mock function with given fields: _a0
-func (_m *KMSAPI) ImportKeyMaterial(_a0 *kms.ImportKeyMaterialInput) (*kms.ImportKeyMaterialOutput, error) {
+// GenerateDataKeyWithoutPlaintext provides a mock function with given fields: _a0
+func (_m *KMSAPI) GenerateDataKeyWithoutPlaintext(_a0 *kms.GenerateDataKeyWithoutPlaintextInput) (*kms.GenerateDataKeyWithoutPlaintextOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.ImportKeyMaterialOutput
-	if rf, ok := ret.Get(0).(func(*kms.ImportKeyMaterialInput) *kms.ImportKeyMaterialOutput); ok {
+	var r0 *kms.GenerateDataKeyWithoutPlaintextOutput
+	if rf, ok := ret.Get(0).(func(*kms.GenerateDataKeyWithoutPlaintextInput) *kms.GenerateDataKeyWithoutPlaintextOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.ImportKeyMaterialOutput)
+			r0 = ret.Get(0).(*kms.GenerateDataKeyWithoutPlaintextOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.ImportKeyMaterialInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.GenerateDataKeyWithoutPlaintextInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1517,12 +1786,12 @@ func (_m *KMSAPI) ImportKeyMaterial(_a0 *kms.ImportKeyMaterialInput) (*kms.Impor
 	return r0, r1
 }
 
-// ImportKeyMaterialRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) ImportKeyMaterialRequest(_a0 *kms.ImportKeyMaterialInput) (*request.Request, *kms.ImportKeyMaterialOutput) {
+// GenerateDataKeyWithoutPlaintextRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) GenerateDataKeyWithoutPlaintextRequest(_a0 *kms.GenerateDataKeyWithoutPlaintextInput) (*request.Request, *kms.GenerateDataKeyWithoutPlaintextOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.ImportKeyMaterialInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.GenerateDataKeyWithoutPlaintextInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1530,20 +1799,20 @@ func (_m *KMSAPI) ImportKeyMaterialRequest(_a0 *kms.ImportKeyMaterialInput) (*re
 		}
 	}
 
-	var r1 *kms.ImportKeyMaterialOutput
-	if rf, ok := ret.Get(1).(func(*kms.ImportKeyMaterialInput) *kms.ImportKeyMaterialOutput); ok {
+	var r1 *kms.GenerateDataKeyWithoutPlaintextOutput
+	if rf, ok := ret.Get(1).(func(*kms.GenerateDataKeyWithoutPlaintextInput) *kms.GenerateDataKeyWithoutPlaintextOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.ImportKeyMaterialOutput)
+			r1 = ret.Get(1).(*kms.GenerateDataKeyWithoutPlaintextOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ImportKeyMaterialWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) ImportKeyMaterialWithContext(_a0 aws.Context, _a1 *kms.ImportKeyMaterialInput, _a2 ...request.Option) (*kms.ImportKeyMaterialOutput, error) {
+// GenerateDataKeyWithoutPlaintextWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) GenerateDataKeyWithoutPlaintextWithContext(_a0 aws.Context, _a1 *kms.GenerateDataKeyWithoutPlaintextInput, _a2 ...request.Option) (*kms.GenerateDataKeyWithoutPlaintextOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1553,17 +1822,17 @@ func (_m *KMSAPI) ImportKeyMaterialWithContext(_a0 aws.Context, _a1 *kms.ImportK
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.ImportKeyMaterialOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ImportKeyMaterialInput, ...request.Option) *kms.ImportKeyMaterialOutput); ok {
+	var r0 *kms.GenerateDataKeyWithoutPlaintextOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.GenerateDataKeyWithoutPlaintextInput, ...request.Option) *kms.GenerateDataKeyWithoutPlaintextOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.ImportKeyMaterialOutput)
+			r0 = ret.Get(0).(*kms.GenerateDataKeyWithoutPlaintextOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.ImportKeyMaterialInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.GenerateDataKeyWithoutPlaintextInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1572,21 +1841,21 @@ func (_m *KMSAPI) ImportKeyMaterialWithContext(_a0 aws.Context, _a1 *kms.ImportK
 	return r0, r1
 }
 
-// ListAliases provides a mock function with given fields: _a0
-func (_m *KMSAPI) ListAliases(_a0 *kms.ListAliasesInput) (*kms.ListAliasesOutput, error) {
+// GenerateMac provides a mock function with given fields: _a0
+func (_m *KMSAPI) GenerateMac(_a0 *kms.GenerateMacInput) (*kms.GenerateMacOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.ListAliasesOutput
-	if rf, ok := ret.Get(0).(func(*kms.ListAliasesInput) *kms.ListAliasesOutput); ok {
+	var r0 *kms.GenerateMacOutput
+	if rf, ok := ret.Get(0).(func(*kms.GenerateMacInput) *kms.GenerateMacOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.ListAliasesOutput)
+			r0 = ret.Get(0).(*kms.GenerateMacOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.ListAliasesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.GenerateMacInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1595,47 +1864,12 @@ func (_m *KMSAPI) ListAliases(_a0 *kms.ListAliasesInput) (*kms.ListAliasesOutput
 	return r0, r1
 }
 
-// ListAliasesPages provides a mock function with given fields: _a0, _a1
-func (_m *KMSAPI) ListAliasesPages(_a0 *kms.ListAliasesInput, _a1 func(*kms.ListAliasesOutput, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*kms.ListAliasesInput, func(*kms.ListAliasesOutput, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// ListAliasesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *KMSAPI) ListAliasesPagesWithContext(_a0 aws.Context, _a1 *kms.ListAliasesInput, _a2 func(*kms.ListAliasesOutput, bool) bool, _a3 ...request.Option) error {
-	_va := make([]interface{}, len(_a3))
-	for _i := range _a3 {
-		_va[_i] = _a3[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1, _a2)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListAliasesInput, func(*kms.ListAliasesOutput, bool) bool, ...request.Option) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3...)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// ListAliasesRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) ListAliasesRequest(_a0 *kms.ListAliasesInput) (*request.Request, *kms.ListAliasesOutput) {
+// GenerateMacRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) GenerateMacRequest(_a0 *kms.GenerateMacInput) (*request.Request, *kms.GenerateMacOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.ListAliasesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.GenerateMacInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1643,20 +1877,20 @@ func (_m *KMSAPI) ListAliasesRequest(_a0 *kms.ListAliasesInput) (*request.Reques
 		}
 	}
 
-	var r1 *kms.ListAliasesOutput
-	if rf, ok := ret.Get(1).(func(*kms.ListAliasesInput) *kms.ListAliasesOutput); ok {
+	var r1 *kms.GenerateMacOutput
+	if rf, ok := ret.Get(1).(func(*kms.GenerateMacInput) *kms.GenerateMacOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.ListAliasesOutput)
+			r1 = ret.Get(1).(*kms.GenerateMacOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListAliasesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) ListAliasesWithContext(_a0 aws.Context, _a1 *kms.ListAliasesInput, _a2 ...request.Option) (*kms.ListAliasesOutput, error) {
+// GenerateMacWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) GenerateMacWithContext(_a0 aws.Context, _a1 *kms.GenerateMacInput, _a2 ...request.Option) (*kms.GenerateMacOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1666,17 +1900,17 @@ func (_m *KMSAPI) ListAliasesWithContext(_a0 aws.Context, _a1 *kms.ListAliasesIn
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.ListAliasesOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListAliasesInput, ...request.Option) *kms.ListAliasesOutput); ok {
+	var r0 *kms.GenerateMacOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.GenerateMacInput, ...request.Option) *kms.GenerateMacOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.ListAliasesOutput)
+			r0 = ret.Get(0).(*kms.GenerateMacOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.ListAliasesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.GenerateMacInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1685,12 +1919,1158 @@ func (_m *KMSAPI) ListAliasesWithContext(_a0 aws.Context, _a1 *kms.ListAliasesIn
 	return r0, r1
 }
 
-// ListGrants provides a mock function with given fields: _a0
-func (_m *KMSAPI) ListGrants(_a0 *kms.ListGrantsInput) (*kms.ListGrantsResponse, error) {
+// GenerateRandom provides a mock function with given fields: _a0
+func (_m *KMSAPI) GenerateRandom(_a0 *kms.GenerateRandomInput) (*kms.GenerateRandomOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.ListGrantsResponse
-	if rf, ok := ret.Get(0).(func(*kms.ListGrantsInput) *kms.ListGrantsResponse); ok {
+	var r0 *kms.GenerateRandomOutput
+	if rf, ok := ret.Get(0).(func(*kms.GenerateRandomInput) *kms.GenerateRandomOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.GenerateRandomOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*kms.GenerateRandomInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GenerateRandomRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) GenerateRandomRequest(_a0 *kms.GenerateRandomInput) (*request.Request, *kms.GenerateRandomOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*kms.GenerateRandomInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *kms.GenerateRandomOutput
+	if rf, ok := ret.Get(1).(func(*kms.GenerateRandomInput) *kms.GenerateRandomOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*kms.GenerateRandomOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GenerateRandomWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) GenerateRandomWithContext(_a0 aws.Context, _a1 *kms.GenerateRandomInput, _a2 ...request.Option) (*kms.GenerateRandomOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *kms.GenerateRandomOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.GenerateRandomInput, ...request.Option) *kms.GenerateRandomOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.GenerateRandomOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.GenerateRandomInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeyPolicy provides a mock function with given fields: _a0
+func (_m *KMSAPI) GetKeyPolicy(_a0 *kms.GetKeyPolicyInput) (*kms.GetKeyPolicyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *kms.GetKeyPolicyOutput
+	if rf, ok := ret.Get(0).(func(*kms.GetKeyPolicyInput) *kms.GetKeyPolicyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.GetKeyPolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*kms.GetKeyPolicyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeyPolicyRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) GetKeyPolicyRequest(_a0 *kms.GetKeyPolicyInput) (*request.Request, *kms.GetKeyPolicyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*kms.GetKeyPolicyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *kms.GetKeyPolicyOutput
+	if rf, ok := ret.Get(1).(func(*kms.GetKeyPolicyInput) *kms.GetKeyPolicyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*kms.GetKeyPolicyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetKeyPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) GetKeyPolicyWithContext(_a0 aws.Context, _a1 *kms.GetKeyPolicyInput, _a2 ...request.Option) (*kms.GetKeyPolicyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *kms.GetKeyPolicyOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.GetKeyPolicyInput, ...request.Option) *kms.GetKeyPolicyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.GetKeyPolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.GetKeyPolicyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeyRotationStatus provides a mock function with given fields: _a0
+func (_m *KMSAPI) GetKeyRotationStatus(_a0 *kms.GetKeyRotationStatusInput) (*kms.GetKeyRotationStatusOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *kms.GetKeyRotationStatusOutput
+	if rf, ok := ret.Get(0).(func(*kms.GetKeyRotationStatusInput) *kms.GetKeyRotationStatusOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.GetKeyRotationStatusOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*kms.GetKeyRotationStatusInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeyRotationStatusRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) GetKeyRotationStatusRequest(_a0 *kms.GetKeyRotationStatusInput) (*request.Request, *kms.GetKeyRotationStatusOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*kms.GetKeyRotationStatusInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *kms.GetKeyRotationStatusOutput
+	if rf, ok := ret.Get(1).(func(*kms.GetKeyRotationStatusInput) *kms.GetKeyRotationStatusOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*kms.GetKeyRotationStatusOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetKeyRotationStatusWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) GetKeyRotationStatusWithContext(_a0 aws.Context, _a1 *kms.GetKeyRotationStatusInput, _a2 ...request.Option) (*kms.GetKeyRotationStatusOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *kms.GetKeyRotationStatusOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.GetKeyRotationStatusInput, ...request.Option) *kms.GetKeyRotationStatusOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.GetKeyRotationStatusOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.GetKeyRotationStatusInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetParametersForImport provides a mock function with given fields: _a0
+func (_m *KMSAPI) GetParametersForImport(_a0 *kms.GetParametersForImportInput) (*kms.GetParametersForImportOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *kms.GetParametersForImportOutput
+	if rf, ok := ret.Get(0).(func(*kms.GetParametersForImportInput) *kms.GetParametersForImportOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.GetParametersForImportOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*kms.GetParametersForImportInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetParametersForImportRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) GetParametersForImportRequest(_a0 *kms.GetParametersForImportInput) (*request.Request, *kms.GetParametersForImportOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*kms.GetParametersForImportInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *kms.GetParametersForImportOutput
+	if rf, ok := ret.Get(1).(func(*kms.GetParametersForImportInput) *kms.GetParametersForImportOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*kms.GetParametersForImportOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetParametersForImportWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) GetParametersForImportWithContext(_a0 aws.Context, _a1 *kms.GetParametersForImportInput, _a2 ...request.Option) (*kms.GetParametersForImportOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *kms.GetParametersForImportOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.GetParametersForImportInput, ...request.Option) *kms.GetParametersForImportOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.GetParametersForImportOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.GetParametersForImportInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPublicKey provides a mock function with given fields: _a0
+func (_m *KMSAPI) GetPublicKey(_a0 *kms.GetPublicKeyInput) (*kms.GetPublicKeyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *kms.GetPublicKeyOutput
+	if rf, ok := ret.Get(0).(func(*kms.GetPublicKeyInput) *kms.GetPublicKeyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.GetPublicKeyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*kms.GetPublicKeyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPublicKeyRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) GetPublicKeyRequest(_a0 *kms.GetPublicKeyInput) (*request.Request, *kms.GetPublicKeyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*kms.GetPublicKeyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *kms.GetPublicKeyOutput
+	if rf, ok := ret.Get(1).(func(*kms.GetPublicKeyInput) *kms.GetPublicKeyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*kms.GetPublicKeyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetPublicKeyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) GetPublicKeyWithContext(_a0 aws.Context, _a1 *kms.GetPublicKeyInput, _a2 ...request.Option) (*kms.GetPublicKeyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *kms.GetPublicKeyOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.GetPublicKeyInput, ...request.Option) *kms.GetPublicKeyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.GetPublicKeyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.GetPublicKeyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportKeyMaterial provides a mock function with given fields: _a0
+func (_m *KMSAPI) ImportKeyMaterial(_a0 *kms.ImportKeyMaterialInput) (*kms.ImportKeyMaterialOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *kms.ImportKeyMaterialOutput
+	if rf, ok := ret.Get(0).(func(*kms.ImportKeyMaterialInput) *kms.ImportKeyMaterialOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.ImportKeyMaterialOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*kms.ImportKeyMaterialInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportKeyMaterialRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) ImportKeyMaterialRequest(_a0 *kms.ImportKeyMaterialInput) (*request.Request, *kms.ImportKeyMaterialOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*kms.ImportKeyMaterialInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *kms.ImportKeyMaterialOutput
+	if rf, ok := ret.Get(1).(func(*kms.ImportKeyMaterialInput) *kms.ImportKeyMaterialOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*kms.ImportKeyMaterialOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ImportKeyMaterialWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) ImportKeyMaterialWithContext(_a0 aws.Context, _a1 *kms.ImportKeyMaterialInput, _a2 ...request.Option) (*kms.ImportKeyMaterialOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *kms.ImportKeyMaterialOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ImportKeyMaterialInput, ...request.Option) *kms.ImportKeyMaterialOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.ImportKeyMaterialOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.ImportKeyMaterialInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAliases provides a mock function with given fields: _a0
+func (_m *KMSAPI) ListAliases(_a0 *kms.ListAliasesInput) (*kms.ListAliasesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *kms.ListAliasesOutput
+	if rf, ok := ret.Get(0).(func(*kms.ListAliasesInput) *kms.ListAliasesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.ListAliasesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*kms.ListAliasesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAliasesPages provides a mock function with given fields: _a0, _a1
+func (_m *KMSAPI) ListAliasesPages(_a0 *kms.ListAliasesInput, _a1 func(*kms.ListAliasesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*kms.ListAliasesInput, func(*kms.ListAliasesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListAliasesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *KMSAPI) ListAliasesPagesWithContext(_a0 aws.Context, _a1 *kms.ListAliasesInput, _a2 func(*kms.ListAliasesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListAliasesInput, func(*kms.ListAliasesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListAliasesRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) ListAliasesRequest(_a0 *kms.ListAliasesInput) (*request.Request, *kms.ListAliasesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*kms.ListAliasesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *kms.ListAliasesOutput
+	if rf, ok := ret.Get(1).(func(*kms.ListAliasesInput) *kms.ListAliasesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*kms.ListAliasesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListAliasesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) ListAliasesWithContext(_a0 aws.Context, _a1 *kms.ListAliasesInput, _a2 ...request.Option) (*kms.ListAliasesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *kms.ListAliasesOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListAliasesInput, ...request.Option) *kms.ListAliasesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.ListAliasesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.ListAliasesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListGrants provides a mock function with given fields: _a0
+func (_m *KMSAPI) ListGrants(_a0 *kms.ListGrantsInput) (*kms.ListGrantsResponse, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *kms.ListGrantsResponse
+	if rf, ok := ret.Get(0).(func(*kms.ListGrantsInput) *kms.ListGrantsResponse); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.ListGrantsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*kms.ListGrantsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListGrantsPages provides a mock function with given fields: _a0, _a1
+func (_m *KMSAPI) ListGrantsPages(_a0 *kms.ListGrantsInput, _a1 func(*kms.ListGrantsResponse, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*kms.ListGrantsInput, func(*kms.ListGrantsResponse, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListGrantsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *KMSAPI) ListGrantsPagesWithContext(_a0 aws.Context, _a1 *kms.ListGrantsInput, _a2 func(*kms.ListGrantsResponse, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListGrantsInput, func(*kms.ListGrantsResponse, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListGrantsRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) ListGrantsRequest(_a0 *kms.ListGrantsInput) (*request.Request, *kms.ListGrantsResponse) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*kms.ListGrantsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *kms.ListGrantsResponse
+	if rf, ok := ret.Get(1).(func(*kms.ListGrantsInput) *kms.ListGrantsResponse); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*kms.ListGrantsResponse)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListGrantsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) ListGrantsWithContext(_a0 aws.Context, _a1 *kms.ListGrantsInput, _a2 ...request.Option) (*kms.ListGrantsResponse, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *kms.ListGrantsResponse
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListGrantsInput, ...request.Option) *kms.ListGrantsResponse); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.ListGrantsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.ListGrantsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListKeyPolicies provides a mock function with given fields: _a0
+func (_m *KMSAPI) ListKeyPolicies(_a0 *kms.ListKeyPoliciesInput) (*kms.ListKeyPoliciesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *kms.ListKeyPoliciesOutput
+	if rf, ok := ret.Get(0).(func(*kms.ListKeyPoliciesInput) *kms.ListKeyPoliciesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.ListKeyPoliciesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*kms.ListKeyPoliciesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListKeyPoliciesPages provides a mock function with given fields: _a0, _a1
+func (_m *KMSAPI) ListKeyPoliciesPages(_a0 *kms.ListKeyPoliciesInput, _a1 func(*kms.ListKeyPoliciesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*kms.ListKeyPoliciesInput, func(*kms.ListKeyPoliciesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListKeyPoliciesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *KMSAPI) ListKeyPoliciesPagesWithContext(_a0 aws.Context, _a1 *kms.ListKeyPoliciesInput, _a2 func(*kms.ListKeyPoliciesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListKeyPoliciesInput, func(*kms.ListKeyPoliciesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListKeyPoliciesRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) ListKeyPoliciesRequest(_a0 *kms.ListKeyPoliciesInput) (*request.Request, *kms.ListKeyPoliciesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*kms.ListKeyPoliciesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *kms.ListKeyPoliciesOutput
+	if rf, ok := ret.Get(1).(func(*kms.ListKeyPoliciesInput) *kms.ListKeyPoliciesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*kms.ListKeyPoliciesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListKeyPoliciesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) ListKeyPoliciesWithContext(_a0 aws.Context, _a1 *kms.ListKeyPoliciesInput, _a2 ...request.Option) (*kms.ListKeyPoliciesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *kms.ListKeyPoliciesOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListKeyPoliciesInput, ...request.Option) *kms.ListKeyPoliciesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.ListKeyPoliciesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.ListKeyPoliciesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListKeyRotations provides a mock function with given fields: _a0
+func (_m *KMSAPI) ListKeyRotations(_a0 *kms.ListKeyRotationsInput) (*kms.ListKeyRotationsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *kms.ListKeyRotationsOutput
+	if rf, ok := ret.Get(0).(func(*kms.ListKeyRotationsInput) *kms.ListKeyRotationsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.ListKeyRotationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*kms.ListKeyRotationsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListKeyRotationsPages provides a mock function with given fields: _a0, _a1
+func (_m *KMSAPI) ListKeyRotationsPages(_a0 *kms.ListKeyRotationsInput, _a1 func(*kms.ListKeyRotationsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*kms.ListKeyRotationsInput, func(*kms.ListKeyRotationsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListKeyRotationsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *KMSAPI) ListKeyRotationsPagesWithContext(_a0 aws.Context, _a1 *kms.ListKeyRotationsInput, _a2 func(*kms.ListKeyRotationsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListKeyRotationsInput, func(*kms.ListKeyRotationsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListKeyRotationsRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) ListKeyRotationsRequest(_a0 *kms.ListKeyRotationsInput) (*request.Request, *kms.ListKeyRotationsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*kms.ListKeyRotationsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *kms.ListKeyRotationsOutput
+	if rf, ok := ret.Get(1).(func(*kms.ListKeyRotationsInput) *kms.ListKeyRotationsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*kms.ListKeyRotationsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListKeyRotationsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) ListKeyRotationsWithContext(_a0 aws.Context, _a1 *kms.ListKeyRotationsInput, _a2 ...request.Option) (*kms.ListKeyRotationsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *kms.ListKeyRotationsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListKeyRotationsInput, ...request.Option) *kms.ListKeyRotationsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.ListKeyRotationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.ListKeyRotationsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListKeys provides a mock function with given fields: _a0
+func (_m *KMSAPI) ListKeys(_a0 *kms.ListKeysInput) (*kms.ListKeysOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *kms.ListKeysOutput
+	if rf, ok := ret.Get(0).(func(*kms.ListKeysInput) *kms.ListKeysOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.ListKeysOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*kms.ListKeysInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListKeysPages provides a mock function with given fields: _a0, _a1
+func (_m *KMSAPI) ListKeysPages(_a0 *kms.ListKeysInput, _a1 func(*kms.ListKeysOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*kms.ListKeysInput, func(*kms.ListKeysOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListKeysPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *KMSAPI) ListKeysPagesWithContext(_a0 aws.Context, _a1 *kms.ListKeysInput, _a2 func(*kms.ListKeysOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListKeysInput, func(*kms.ListKeysOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListKeysRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) ListKeysRequest(_a0 *kms.ListKeysInput) (*request.Request, *kms.ListKeysOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*kms.ListKeysInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *kms.ListKeysOutput
+	if rf, ok := ret.Get(1).(func(*kms.ListKeysInput) *kms.ListKeysOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*kms.ListKeysOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListKeysWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) ListKeysWithContext(_a0 aws.Context, _a1 *kms.ListKeysInput, _a2 ...request.Option) (*kms.ListKeysOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *kms.ListKeysOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListKeysInput, ...request.Option) *kms.ListKeysOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.ListKeysOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.ListKeysInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListResourceTags provides a mock function with given fields: _a0
+func (_m *KMSAPI) ListResourceTags(_a0 *kms.ListResourceTagsInput) (*kms.ListResourceTagsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *kms.ListResourceTagsOutput
+	if rf, ok := ret.Get(0).(func(*kms.ListResourceTagsInput) *kms.ListResourceTagsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.ListResourceTagsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*kms.ListResourceTagsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListResourceTagsPages provides a mock function with given fields: _a0, _a1
+func (_m *KMSAPI) ListResourceTagsPages(_a0 *kms.ListResourceTagsInput, _a1 func(*kms.ListResourceTagsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*kms.ListResourceTagsInput, func(*kms.ListResourceTagsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListResourceTagsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *KMSAPI) ListResourceTagsPagesWithContext(_a0 aws.Context, _a1 *kms.ListResourceTagsInput, _a2 func(*kms.ListResourceTagsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListResourceTagsInput, func(*kms.ListResourceTagsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListResourceTagsRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) ListResourceTagsRequest(_a0 *kms.ListResourceTagsInput) (*request.Request, *kms.ListResourceTagsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*kms.ListResourceTagsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *kms.ListResourceTagsOutput
+	if rf, ok := ret.Get(1).(func(*kms.ListResourceTagsInput) *kms.ListResourceTagsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*kms.ListResourceTagsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListResourceTagsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) ListResourceTagsWithContext(_a0 aws.Context, _a1 *kms.ListResourceTagsInput, _a2 ...request.Option) (*kms.ListResourceTagsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *kms.ListResourceTagsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListResourceTagsInput, ...request.Option) *kms.ListResourceTagsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.ListResourceTagsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.ListResourceTagsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRetirableGrants provides a mock function with given fields: _a0
+func (_m *KMSAPI) ListRetirableGrants(_a0 *kms.ListRetirableGrantsInput) (*kms.ListGrantsResponse, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *kms.ListGrantsResponse
+	if rf, ok := ret.Get(0).(func(*kms.ListRetirableGrantsInput) *kms.ListGrantsResponse); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1699,7 +3079,7 @@ func (_m *KMSAPI) ListGrants(_a0 *kms.ListGrantsInput) (*kms.ListGrantsResponse,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.ListGrantsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.ListRetirableGrantsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1708,12 +3088,12 @@ func (_m *KMSAPI) ListGrants(_a0 *kms.ListGrantsInput) (*kms.ListGrantsResponse,
 	return r0, r1
 }
 
-// ListGrantsPages provides a mock function with given fields: _a0, _a1
-func (_m *KMSAPI) ListGrantsPages(_a0 *kms.ListGrantsInput, _a1 func(*kms.ListGrantsResponse, bool) bool) error {
+// ListRetirableGrantsPages provides a mock function with given fields: _a0, _a1
+func (_m *KMSAPI) ListRetirableGrantsPages(_a0 *kms.ListRetirableGrantsInput, _a1 func(*kms.ListGrantsResponse, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*kms.ListGrantsInput, func(*kms.ListGrantsResponse, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*kms.ListRetirableGrantsInput, func(*kms.ListGrantsResponse, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -1722,8 +3102,8 @@ func (_m *KMSAPI) ListGrantsPages(_a0 *kms.ListGrantsInput, _a1 func(*kms.ListGr
 	return r0
 }
 
-// ListGrantsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *KMSAPI) ListGrantsPagesWithContext(_a0 aws.Context, _a1 *kms.ListGrantsInput, _a2 func(*kms.ListGrantsResponse, bool) bool, _a3 ...request.Option) error {
+// ListRetirableGrantsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *KMSAPI) ListRetirableGrantsPagesWithContext(_a0 aws.Context, _a1 *kms.ListRetirableGrantsInput, _a2 func(*kms.ListGrantsResponse, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -1734,7 +3114,7 @@ func (_m *KMSAPI) ListGrantsPagesWithContext(_a0 aws.Context, _a1 *kms.ListGrant
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListGrantsInput, func(*kms.ListGrantsResponse, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListRetirableGrantsInput, func(*kms.ListGrantsResponse, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -1743,12 +3123,168 @@ func (_m *KMSAPI) ListGrantsPagesWithContext(_a0 aws.Context, _a1 *kms.ListGrant
 	return r0
 }
 
-// ListGrantsRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) ListGrantsRequest(_a0 *kms.ListGrantsInput) (*request.Request, *kms.ListGrantsResponse) {
+// ListRetirableGrantsRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) ListRetirableGrantsRequest(_a0 *kms.ListRetirableGrantsInput) (*request.Request, *kms.ListGrantsResponse) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*kms.ListRetirableGrantsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *kms.ListGrantsResponse
+	if rf, ok := ret.Get(1).(func(*kms.ListRetirableGrantsInput) *kms.ListGrantsResponse); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*kms.ListGrantsResponse)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListRetirableGrantsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) ListRetirableGrantsWithContext(_a0 aws.Context, _a1 *kms.ListRetirableGrantsInput, _a2 ...request.Option) (*kms.ListGrantsResponse, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *kms.ListGrantsResponse
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListRetirableGrantsInput, ...request.Option) *kms.ListGrantsResponse); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.ListGrantsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.ListRetirableGrantsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutKeyPolicy provides a mock function with given fields: _a0
+func (_m *KMSAPI) PutKeyPolicy(_a0 *kms.PutKeyPolicyInput) (*kms.PutKeyPolicyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *kms.PutKeyPolicyOutput
+	if rf, ok := ret.Get(0).(func(*kms.PutKeyPolicyInput) *kms.PutKeyPolicyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.PutKeyPolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*kms.PutKeyPolicyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutKeyPolicyRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) PutKeyPolicyRequest(_a0 *kms.PutKeyPolicyInput) (*request.Request, *kms.PutKeyPolicyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*kms.PutKeyPolicyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *kms.PutKeyPolicyOutput
+	if rf, ok := ret.Get(1).(func(*kms.PutKeyPolicyInput) *kms.PutKeyPolicyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*kms.PutKeyPolicyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// PutKeyPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) PutKeyPolicyWithContext(_a0 aws.Context, _a1 *kms.PutKeyPolicyInput, _a2 ...request.Option) (*kms.PutKeyPolicyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *kms.PutKeyPolicyOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.PutKeyPolicyInput, ...request.Option) *kms.PutKeyPolicyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.PutKeyPolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.PutKeyPolicyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReEncrypt provides a mock function with given fields: _a0
+func (_m *KMSAPI) ReEncrypt(_a0 *kms.ReEncryptInput) (*kms.ReEncryptOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *kms.ReEncryptOutput
+	if rf, ok := ret.Get(0).(func(*kms.ReEncryptInput) *kms.ReEncryptOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.ReEncryptOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*kms.ReEncryptInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReEncryptRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) ReEncryptRequest(_a0 *kms.ReEncryptInput) (*request.Request, *kms.ReEncryptOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.ListGrantsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.ReEncryptInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1756,20 +3292,20 @@ func (_m *KMSAPI) ListGrantsRequest(_a0 *kms.ListGrantsInput) (*request.Request,
 		}
 	}
 
-	var r1 *kms.ListGrantsResponse
-	if rf, ok := ret.Get(1).(func(*kms.ListGrantsInput) *kms.ListGrantsResponse); ok {
+	var r1 *kms.ReEncryptOutput
+	if rf, ok := ret.Get(1).(func(*kms.ReEncryptInput) *kms.ReEncryptOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.ListGrantsResponse)
+			r1 = ret.Get(1).(*kms.ReEncryptOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListGrantsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) ListGrantsWithContext(_a0 aws.Context, _a1 *kms.ListGrantsInput, _a2 ...request.Option) (*kms.ListGrantsResponse, error) {
+// ReEncryptWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) ReEncryptWithContext(_a0 aws.Context, _a1 *kms.ReEncryptInput, _a2 ...request.Option) (*kms.ReEncryptOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1779,17 +3315,17 @@ func (_m *KMSAPI) ListGrantsWithContext(_a0 aws.Context, _a1 *kms.ListGrantsInpu
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.ListGrantsResponse
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListGrantsInput, ...request.Option) *kms.ListGrantsResponse); ok {
+	var r0 *kms.ReEncryptOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ReEncryptInput, ...request.Option) *kms.ReEncryptOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.ListGrantsResponse)
+			r0 = ret.Get(0).(*kms.ReEncryptOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.ListGrantsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.ReEncryptInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1798,21 +3334,21 @@ func (_m *KMSAPI) ListGrantsWithContext(_a0 aws.Context, _a1 *kms.ListGrantsInpu
 	return r0, r1
 }
 
-// ListKeyPolicies provides a mock function with given fields: _a0
-func (_m *KMSAPI) ListKeyPolicies(_a0 *kms.ListKeyPoliciesInput) (*kms.ListKeyPoliciesOutput, error) {
+// ReplicateKey provides a mock function with given fields: _a0
+func (_m *KMSAPI) ReplicateKey(_a0 *kms.ReplicateKeyInput) (*kms.ReplicateKeyOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.ListKeyPoliciesOutput
-	if rf, ok := ret.Get(0).(func(*kms.ListKeyPoliciesInput) *kms.ListKeyPoliciesOutput); ok {
+	var r0 *kms.ReplicateKeyOutput
+	if rf, ok := ret.Get(0).(func(*kms.ReplicateKeyInput) *kms.ReplicateKeyOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.ListKeyPoliciesOutput)
+			r0 = ret.Get(0).(*kms.ReplicateKeyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.ListKeyPoliciesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.ReplicateKeyInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1821,47 +3357,12 @@ func (_m *KMSAPI) ListKeyPolicies(_a0 *kms.ListKeyPoliciesInput) (*kms.ListKeyPo
 	return r0, r1
 }
 
-// ListKeyPoliciesPages provides a mock function with given fields: _a0, _a1
-func (_m *KMSAPI) ListKeyPoliciesPages(_a0 *kms.ListKeyPoliciesInput, _a1 func(*kms.ListKeyPoliciesOutput, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*kms.ListKeyPoliciesInput, func(*kms.ListKeyPoliciesOutput, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// ListKeyPoliciesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *KMSAPI) ListKeyPoliciesPagesWithContext(_a0 aws.Context, _a1 *kms.ListKeyPoliciesInput, _a2 func(*kms.ListKeyPoliciesOutput, bool) bool, _a3 ...request.Option) error {
-	_va := make([]interface{}, len(_a3))
-	for _i := range _a3 {
-		_va[_i] = _a3[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1, _a2)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListKeyPoliciesInput, func(*kms.ListKeyPoliciesOutput, bool) bool, ...request.Option) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3...)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// ListKeyPoliciesRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) ListKeyPoliciesRequest(_a0 *kms.ListKeyPoliciesInput) (*request.Request, *kms.ListKeyPoliciesOutput) {
+// ReplicateKeyRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) ReplicateKeyRequest(_a0 *kms.ReplicateKeyInput) (*request.Request, *kms.ReplicateKeyOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.ListKeyPoliciesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.ReplicateKeyInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1869,20 +3370,20 @@ func (_m *KMSAPI) ListKeyPoliciesRequest(_a0 *kms.ListKeyPoliciesInput) (*reques
 		}
 	}
 
-	var r1 *kms.ListKeyPoliciesOutput
-	if rf, ok := ret.Get(1).(func(*kms.ListKeyPoliciesInput) *kms.ListKeyPoliciesOutput); ok {
+	var r1 *kms.ReplicateKeyOutput
+	if rf, ok := ret.Get(1).(func(*kms.ReplicateKeyInput) *kms.ReplicateKeyOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.ListKeyPoliciesOutput)
+			r1 = ret.Get(1).(*kms.ReplicateKeyOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListKeyPoliciesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) ListKeyPoliciesWithContext(_a0 aws.Context, _a1 *kms.ListKeyPoliciesInput, _a2 ...request.Option) (*kms.ListKeyPoliciesOutput, error) {
+// ReplicateKeyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) ReplicateKeyWithContext(_a0 aws.Context, _a1 *kms.ReplicateKeyInput, _a2 ...request.Option) (*kms.ReplicateKeyOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1892,17 +3393,17 @@ func (_m *KMSAPI) ListKeyPoliciesWithContext(_a0 aws.Context, _a1 *kms.ListKeyPo
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.ListKeyPoliciesOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListKeyPoliciesInput, ...request.Option) *kms.ListKeyPoliciesOutput); ok {
+	var r0 *kms.ReplicateKeyOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ReplicateKeyInput, ...request.Option) *kms.ReplicateKeyOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.ListKeyPoliciesOutput)
+			r0 = ret.Get(0).(*kms.ReplicateKeyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.ListKeyPoliciesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.ReplicateKeyInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1911,21 +3412,21 @@ func (_m *KMSAPI) ListKeyPoliciesWithContext(_a0 aws.Context, _a1 *kms.ListKeyPo
 	return r0, r1
 }
 
-// ListKeys provides a mock function with given fields: _a0
-func (_m *KMSAPI) ListKeys(_a0 *kms.ListKeysInput) (*kms.ListKeysOutput, error) {
+// RetireGrant provides a mock function with given fields: _a0
+func (_m *KMSAPI) RetireGrant(_a0 *kms.RetireGrantInput) (*kms.RetireGrantOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.ListKeysOutput
-	if rf, ok := ret.Get(0).(func(*kms.ListKeysInput) *kms.ListKeysOutput); ok {
+	var r0 *kms.RetireGrantOutput
+	if rf, ok := ret.Get(0).(func(*kms.RetireGrantInput) *kms.RetireGrantOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.ListKeysOutput)
+			r0 = ret.Get(0).(*kms.RetireGrantOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.ListKeysInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.RetireGrantInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1934,47 +3435,90 @@ func (_m *KMSAPI) ListKeys(_a0 *kms.ListKeysInput) (*kms.ListKeysOutput, error)
 	return r0, r1
 }
 
-// ListKeysPages provides a mock function with given fields: _a0, _a1
-func (_m *KMSAPI) ListKeysPages(_a0 *kms.ListKeysInput, _a1 func(*kms.ListKeysOutput, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
+// RetireGrantRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) RetireGrantRequest(_a0 *kms.RetireGrantInput) (*request.Request, *kms.RetireGrantOutput) {
+	ret := _m.Called(_a0)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*kms.ListKeysInput, func(*kms.ListKeysOutput, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*kms.RetireGrantInput) *request.Request); ok {
+		r0 = rf(_a0)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
 	}
 
-	return r0
+	var r1 *kms.RetireGrantOutput
+	if rf, ok := ret.Get(1).(func(*kms.RetireGrantInput) *kms.RetireGrantOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*kms.RetireGrantOutput)
+		}
+	}
+
+	return r0, r1
 }
 
-// ListKeysPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *KMSAPI) ListKeysPagesWithContext(_a0 aws.Context, _a1 *kms.ListKeysInput, _a2 func(*kms.ListKeysOutput, bool) bool, _a3 ...request.Option) error {
-	_va := make([]interface{}, len(_a3))
-	for _i := range _a3 {
-		_va[_i] = _a3[_i]
+// RetireGrantWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) RetireGrantWithContext(_a0 aws.Context, _a1 *kms.RetireGrantInput, _a2 ...request.Option) (*kms.RetireGrantOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _a0, _a1)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListKeysInput, func(*kms.ListKeysOutput, bool) bool, ...request.Option) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3...)
+	var r0 *kms.RetireGrantOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.RetireGrantInput, ...request.Option) *kms.RetireGrantOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.RetireGrantOutput)
+		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.RetireGrantInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// ListKeysRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) ListKeysRequest(_a0 *kms.ListKeysInput) (*request.Request, *kms.ListKeysOutput) {
+// RevokeGrant provides a mock function with given fields: _a0
+func (_m *KMSAPI) RevokeGrant(_a0 *kms.RevokeGrantInput) (*kms.RevokeGrantOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *kms.RevokeGrantOutput
+	if rf, ok := ret.Get(0).(func(*kms.RevokeGrantInput) *kms.RevokeGrantOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.RevokeGrantOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*kms.RevokeGrantInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeGrantRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) RevokeGrantRequest(_a0 *kms.RevokeGrantInput) (*request.Request, *kms.RevokeGrantOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.ListKeysInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.RevokeGrantInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1982,20 +3526,20 @@ func (_m *KMSAPI) ListKeysRequest(_a0 *kms.ListKeysInput) (*request.Request, *km
 		}
 	}
 
-	var r1 *kms.ListKeysOutput
-	if rf, ok := ret.Get(1).(func(*kms.ListKeysInput) *kms.ListKeysOutput); ok {
+	var r1 *kms.RevokeGrantOutput
+	if rf, ok := ret.Get(1).(func(*kms.RevokeGrantInput) *kms.RevokeGrantOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.ListKeysOutput)
+			r1 = ret.Get(1).(*kms.RevokeGrantOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListKeysWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) ListKeysWithContext(_a0 aws.Context, _a1 *kms.ListKeysInput, _a2 ...request.Option) (*kms.ListKeysOutput, error) {
+// RevokeGrantWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) RevokeGrantWithContext(_a0 aws.Context, _a1 *kms.RevokeGrantInput, _a2 ...request.Option) (*kms.RevokeGrantOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2005,17 +3549,17 @@ func (_m *KMSAPI) ListKeysWithContext(_a0 aws.Context, _a1 *kms.ListKeysInput, _
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.ListKeysOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListKeysInput, ...request.Option) *kms.ListKeysOutput); ok {
+	var r0 *kms.RevokeGrantOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.RevokeGrantInput, ...request.Option) *kms.RevokeGrantOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.ListKeysOutput)
+			r0 = ret.Get(0).(*kms.RevokeGrantOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.ListKeysInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.RevokeGrantInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2024,21 +3568,21 @@ func (_m *KMSAPI) ListKeysWithContext(_a0 aws.Context, _a1 *kms.ListKeysInput, _
 	return r0, r1
 }
 
-// ListResourceTags provides a mock function with given fields: _a0
-func (_m *KMSAPI) ListResourceTags(_a0 *kms.ListResourceTagsInput) (*kms.ListResourceTagsOutput, error) {
+// RotateKeyOnDemand provides a mock function with given fields: _a0
+func (_m *KMSAPI) RotateKeyOnDemand(_a0 *kms.RotateKeyOnDemandInput) (*kms.RotateKeyOnDemandOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.ListResourceTagsOutput
-	if rf, ok := ret.Get(0).(func(*kms.ListResourceTagsInput) *kms.ListResourceTagsOutput); ok {
+	var r0 *kms.RotateKeyOnDemandOutput
+	if rf, ok := ret.Get(0).(func(*kms.RotateKeyOnDemandInput) *kms.RotateKeyOnDemandOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.ListResourceTagsOutput)
+			r0 = ret.Get(0).(*kms.RotateKeyOnDemandOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.ListResourceTagsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.RotateKeyOnDemandInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2047,12 +3591,12 @@ func (_m *KMSAPI) ListResourceTags(_a0 *kms.ListResourceTagsInput) (*kms.ListRes
 	return r0, r1
 }
 
-// ListResourceTagsRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) ListResourceTagsRequest(_a0 *kms.ListResourceTagsInput) (*request.Request, *kms.ListResourceTagsOutput) {
+// RotateKeyOnDemandRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) RotateKeyOnDemandRequest(_a0 *kms.RotateKeyOnDemandInput) (*request.Request, *kms.RotateKeyOnDemandOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.ListResourceTagsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.RotateKeyOnDemandInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2060,20 +3604,20 @@ func (_m *KMSAPI) ListResourceTagsRequest(_a0 *kms.ListResourceTagsInput) (*requ
 		}
 	}
 
-	var r1 *kms.ListResourceTagsOutput
-	if rf, ok := ret.Get(1).(func(*kms.ListResourceTagsInput) *kms.ListResourceTagsOutput); ok {
+	var r1 *kms.RotateKeyOnDemandOutput
+	if rf, ok := ret.Get(1).(func(*kms.RotateKeyOnDemandInput) *kms.RotateKeyOnDemandOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.ListResourceTagsOutput)
+			r1 = ret.Get(1).(*kms.RotateKeyOnDemandOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListResourceTagsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) ListResourceTagsWithContext(_a0 aws.Context, _a1 *kms.ListResourceTagsInput, _a2 ...request.Option) (*kms.ListResourceTagsOutput, error) {
+// RotateKeyOnDemandWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) RotateKeyOnDemandWithContext(_a0 aws.Context, _a1 *kms.RotateKeyOnDemandInput, _a2 ...request.Option) (*kms.RotateKeyOnDemandOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2083,17 +3627,17 @@ func (_m *KMSAPI) ListResourceTagsWithContext(_a0 aws.Context, _a1 *kms.ListReso
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.ListResourceTagsOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListResourceTagsInput, ...request.Option) *kms.ListResourceTagsOutput); ok {
+	var r0 *kms.RotateKeyOnDemandOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.RotateKeyOnDemandInput, ...request.Option) *kms.RotateKeyOnDemandOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.ListResourceTagsOutput)
+			r0 = ret.Get(0).(*kms.RotateKeyOnDemandOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.ListResourceTagsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.RotateKeyOnDemandInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2102,21 +3646,21 @@ func (_m *KMSAPI) ListResourceTagsWithContext(_a0 aws.Context, _a1 *kms.ListReso
 	return r0, r1
 }
 
-// ListRetirableGrants provides a mock function with given fields: _a0
-func (_m *KMSAPI) ListRetirableGrants(_a0 *kms.ListRetirableGrantsInput) (*kms.ListGrantsResponse, error) {
+// ScheduleKeyDeletion provides a mock function with given fields: _a0
+func (_m *KMSAPI) ScheduleKeyDeletion(_a0 *kms.ScheduleKeyDeletionInput) (*kms.ScheduleKeyDeletionOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.ListGrantsResponse
-	if rf, ok := ret.Get(0).(func(*kms.ListRetirableGrantsInput) *kms.ListGrantsResponse); ok {
+	var r0 *kms.ScheduleKeyDeletionOutput
+	if rf, ok := ret.Get(0).(func(*kms.ScheduleKeyDeletionInput) *kms.ScheduleKeyDeletionOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.ListGrantsResponse)
+			r0 = ret.Get(0).(*kms.ScheduleKeyDeletionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.ListRetirableGrantsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.ScheduleKeyDeletionInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2125,12 +3669,12 @@ func (_m *KMSAPI) ListRetirableGrants(_a0 *kms.ListRetirableGrantsInput) (*kms.L
 	return r0, r1
 }
 
-// ListRetirableGrantsRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) ListRetirableGrantsRequest(_a0 *kms.ListRetirableGrantsInput) (*request.Request, *kms.ListGrantsResponse) {
+// ScheduleKeyDeletionRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) ScheduleKeyDeletionRequest(_a0 *kms.ScheduleKeyDeletionInput) (*request.Request, *kms.ScheduleKeyDeletionOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.ListRetirableGrantsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.ScheduleKeyDeletionInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2138,20 +3682,20 @@ func (_m *KMSAPI) ListRetirableGrantsRequest(_a0 *kms.ListRetirableGrantsInput)
 		}
 	}
 
-	var r1 *kms.ListGrantsResponse
-	if rf, ok := ret.Get(1).(func(*kms.ListRetirableGrantsInput) *kms.ListGrantsResponse); ok {
+	var r1 *kms.ScheduleKeyDeletionOutput
+	if rf, ok := ret.Get(1).(func(*kms.ScheduleKeyDeletionInput) *kms.ScheduleKeyDeletionOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.ListGrantsResponse)
+			r1 = ret.Get(1).(*kms.ScheduleKeyDeletionOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListRetirableGrantsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) ListRetirableGrantsWithContext(_a0 aws.Context, _a1 *kms.ListRetirableGrantsInput, _a2 ...request.Option) (*kms.ListGrantsResponse, error) {
+// ScheduleKeyDeletionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) ScheduleKeyDeletionWithContext(_a0 aws.Context, _a1 *kms.ScheduleKeyDeletionInput, _a2 ...request.Option) (*kms.ScheduleKeyDeletionOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2161,17 +3705,17 @@ func (_m *KMSAPI) ListRetirableGrantsWithContext(_a0 aws.Context, _a1 *kms.ListR
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.ListGrantsResponse
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ListRetirableGrantsInput, ...request.Option) *kms.ListGrantsResponse); ok {
+	var r0 *kms.ScheduleKeyDeletionOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ScheduleKeyDeletionInput, ...request.Option) *kms.ScheduleKeyDeletionOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.ListGrantsResponse)
+			r0 = ret.Get(0).(*kms.ScheduleKeyDeletionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.ListRetirableGrantsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.ScheduleKeyDeletionInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2180,21 +3724,21 @@ func (_m *KMSAPI) ListRetirableGrantsWithContext(_a0 aws.Context, _a1 *kms.ListR
 	return r0, r1
 }
 
-// PutKeyPolicy provides a mock function with given fields: _a0
-func (_m *KMSAPI) PutKeyPolicy(_a0 *kms.PutKeyPolicyInput) (*kms.PutKeyPolicyOutput, error) {
+// Sign provides a mock function with given fields: _a0
+func (_m *KMSAPI) Sign(_a0 *kms.SignInput) (*kms.SignOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.PutKeyPolicyOutput
-	if rf, ok := ret.Get(0).(func(*kms.PutKeyPolicyInput) *kms.PutKeyPolicyOutput); ok {
+	var r0 *kms.SignOutput
+	if rf, ok := ret.Get(0).(func(*kms.SignInput) *kms.SignOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.PutKeyPolicyOutput)
+			r0 = ret.Get(0).(*kms.SignOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.PutKeyPolicyInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.SignInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2203,12 +3747,12 @@ func (_m *KMSAPI) PutKeyPolicy(_a0 *kms.PutKeyPolicyInput) (*kms.PutKeyPolicyOut
 	return r0, r1
 }
 
-// PutKeyPolicyRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) PutKeyPolicyRequest(_a0 *kms.PutKeyPolicyInput) (*request.Request, *kms.PutKeyPolicyOutput) {
+// SignRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) SignRequest(_a0 *kms.SignInput) (*request.Request, *kms.SignOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.PutKeyPolicyInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.SignInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2216,20 +3760,20 @@ func (_m *KMSAPI) PutKeyPolicyRequest(_a0 *kms.PutKeyPolicyInput) (*request.Requ
 		}
 	}
 
-	var r1 *kms.PutKeyPolicyOutput
-	if rf, ok := ret.Get(1).(func(*kms.PutKeyPolicyInput) *kms.PutKeyPolicyOutput); ok {
+	var r1 *kms.SignOutput
+	if rf, ok := ret.Get(1).(func(*kms.SignInput) *kms.SignOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.PutKeyPolicyOutput)
+			r1 = ret.Get(1).(*kms.SignOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// PutKeyPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) PutKeyPolicyWithContext(_a0 aws.Context, _a1 *kms.PutKeyPolicyInput, _a2 ...request.Option) (*kms.PutKeyPolicyOutput, error) {
+// SignWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) SignWithContext(_a0 aws.Context, _a1 *kms.SignInput, _a2 ...request.Option) (*kms.SignOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2239,17 +3783,17 @@ func (_m *KMSAPI) PutKeyPolicyWithContext(_a0 aws.Context, _a1 *kms.PutKeyPolicy
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.PutKeyPolicyOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.PutKeyPolicyInput, ...request.Option) *kms.PutKeyPolicyOutput); ok {
+	var r0 *kms.SignOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.SignInput, ...request.Option) *kms.SignOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.PutKeyPolicyOutput)
+			r0 = ret.Get(0).(*kms.SignOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.PutKeyPolicyInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.SignInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2258,21 +3802,21 @@ func (_m *KMSAPI) PutKeyPolicyWithContext(_a0 aws.Context, _a1 *kms.PutKeyPolicy
 	return r0, r1
 }
 
-// ReEncrypt provides a mock function with given fields: _a0
-func (_m *KMSAPI) ReEncrypt(_a0 *kms.ReEncryptInput) (*kms.ReEncryptOutput, error) {
+// TagResource provides a mock function with given fields: _a0
+func (_m *KMSAPI) TagResource(_a0 *kms.TagResourceInput) (*kms.TagResourceOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.ReEncryptOutput
-	if rf, ok := ret.Get(0).(func(*kms.ReEncryptInput) *kms.ReEncryptOutput); ok {
+	var r0 *kms.TagResourceOutput
+	if rf, ok := ret.Get(0).(func(*kms.TagResourceInput) *kms.TagResourceOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.ReEncryptOutput)
+			r0 = ret.Get(0).(*kms.TagResourceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.ReEncryptInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.TagResourceInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2281,12 +3825,12 @@ func (_m *KMSAPI) ReEncrypt(_a0 *kms.ReEncryptInput) (*kms.ReEncryptOutput, erro
 	return r0, r1
 }
 
-// ReEncryptRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) ReEncryptRequest(_a0 *kms.ReEncryptInput) (*request.Request, *kms.ReEncryptOutput) {
+// TagResourceRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) TagResourceRequest(_a0 *kms.TagResourceInput) (*request.Request, *kms.TagResourceOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.ReEncryptInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.TagResourceInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2294,20 +3838,20 @@ func (_m *KMSAPI) ReEncryptRequest(_a0 *kms.ReEncryptInput) (*request.Request, *
 		}
 	}
 
-	var r1 *kms.ReEncryptOutput
-	if rf, ok := ret.Get(1).(func(*kms.ReEncryptInput) *kms.ReEncryptOutput); ok {
+	var r1 *kms.TagResourceOutput
+	if rf, ok := ret.Get(1).(func(*kms.TagResourceInput) *kms.TagResourceOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.ReEncryptOutput)
+			r1 = ret.Get(1).(*kms.TagResourceOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ReEncryptWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) ReEncryptWithContext(_a0 aws.Context, _a1 *kms.ReEncryptInput, _a2 ...request.Option) (*kms.ReEncryptOutput, error) {
+// TagResourceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) TagResourceWithContext(_a0 aws.Context, _a1 *kms.TagResourceInput, _a2 ...request.Option) (*kms.TagResourceOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2317,17 +3861,17 @@ func (_m *KMSAPI) ReEncryptWithContext(_a0 aws.Context, _a1 *kms.ReEncryptInput,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.ReEncryptOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ReEncryptInput, ...request.Option) *kms.ReEncryptOutput); ok {
+	var r0 *kms.TagResourceOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.TagResourceInput, ...request.Option) *kms.TagResourceOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.ReEncryptOutput)
+			r0 = ret.Get(0).(*kms.TagResourceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.ReEncryptInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.TagResourceInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2336,21 +3880,21 @@ func (_m *KMSAPI) ReEncryptWithContext(_a0 aws.Context, _a1 *kms.ReEncryptInput,
 	return r0, r1
 }
 
-// RetireGrant provides a mock function with given fields: _a0
-func (_m *KMSAPI) RetireGrant(_a0 *kms.RetireGrantInput) (*kms.RetireGrantOutput, error) {
+// UntagResource provides a mock function with given fields: _a0
+func (_m *KMSAPI) UntagResource(_a0 *kms.UntagResourceInput) (*kms.UntagResourceOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.RetireGrantOutput
-	if rf, ok := ret.Get(0).(func(*kms.RetireGrantInput) *kms.RetireGrantOutput); ok {
+	var r0 *kms.UntagResourceOutput
+	if rf, ok := ret.Get(0).(func(*kms.UntagResourceInput) *kms.UntagResourceOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.RetireGrantOutput)
+			r0 = ret.Get(0).(*kms.UntagResourceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.RetireGrantInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.UntagResourceInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2359,12 +3903,12 @@ func (_m *KMSAPI) RetireGrant(_a0 *kms.RetireGrantInput) (*kms.RetireGrantOutput
 	return r0, r1
 }
 
-// RetireGrantRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) RetireGrantRequest(_a0 *kms.RetireGrantInput) (*request.Request, *kms.RetireGrantOutput) {
+// UntagResourceRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) UntagResourceRequest(_a0 *kms.UntagResourceInput) (*request.Request, *kms.UntagResourceOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.RetireGrantInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.UntagResourceInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2372,20 +3916,20 @@ func (_m *KMSAPI) RetireGrantRequest(_a0 *kms.RetireGrantInput) (*request.Reques
 		}
 	}
 
-	var r1 *kms.RetireGrantOutput
-	if rf, ok := ret.Get(1).(func(*kms.RetireGrantInput) *kms.RetireGrantOutput); ok {
+	var r1 *kms.UntagResourceOutput
+	if rf, ok := ret.Get(1).(func(*kms.UntagResourceInput) *kms.UntagResourceOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.RetireGrantOutput)
+			r1 = ret.Get(1).(*kms.UntagResourceOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// RetireGrantWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) RetireGrantWithContext(_a0 aws.Context, _a1 *kms.RetireGrantInput, _a2 ...request.Option) (*kms.RetireGrantOutput, error) {
+// UntagResourceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) UntagResourceWithContext(_a0 aws.Context, _a1 *kms.UntagResourceInput, _a2 ...request.Option) (*kms.UntagResourceOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2395,17 +3939,17 @@ func (_m *KMSAPI) RetireGrantWithContext(_a0 aws.Context, _a1 *kms.RetireGrantIn
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.RetireGrantOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.RetireGrantInput, ...request.Option) *kms.RetireGrantOutput); ok {
+	var r0 *kms.UntagResourceOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.UntagResourceInput, ...request.Option) *kms.UntagResourceOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.RetireGrantOutput)
+			r0 = ret.Get(0).(*kms.UntagResourceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.RetireGrantInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.UntagResourceInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2414,21 +3958,21 @@ func (_m *KMSAPI) RetireGrantWithContext(_a0 aws.Context, _a1 *kms.RetireGrantIn
 	return r0, r1
 }
 
-// RevokeGrant provides a mock function with given fields: _a0
-func (_m *KMSAPI) RevokeGrant(_a0 *kms.RevokeGrantInput) (*kms.RevokeGrantOutput, error) {
+// UpdateAlias provides a mock function with given fields: _a0
+func (_m *KMSAPI) UpdateAlias(_a0 *kms.UpdateAliasInput) (*kms.UpdateAliasOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.RevokeGrantOutput
-	if rf, ok := ret.Get(0).(func(*kms.RevokeGrantInput) *kms.RevokeGrantOutput); ok {
+	var r0 *kms.UpdateAliasOutput
+	if rf, ok := ret.Get(0).(func(*kms.UpdateAliasInput) *kms.UpdateAliasOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.RevokeGrantOutput)
+			r0 = ret.Get(0).(*kms.UpdateAliasOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.RevokeGrantInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.UpdateAliasInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2437,12 +3981,12 @@ func (_m *KMSAPI) RevokeGrant(_a0 *kms.RevokeGrantInput) (*kms.RevokeGrantOutput
 	return r0, r1
 }
 
-// RevokeGrantRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) RevokeGrantRequest(_a0 *kms.RevokeGrantInput) (*request.Request, *kms.RevokeGrantOutput) {
+// UpdateAliasRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) UpdateAliasRequest(_a0 *kms.UpdateAliasInput) (*request.Request, *kms.UpdateAliasOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.RevokeGrantInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.UpdateAliasInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2450,20 +3994,20 @@ func (_m *KMSAPI) RevokeGrantRequest(_a0 *kms.RevokeGrantInput) (*request.Reques
 		}
 	}
 
-	var r1 *kms.RevokeGrantOutput
-	if rf, ok := ret.Get(1).(func(*kms.RevokeGrantInput) *kms.RevokeGrantOutput); ok {
+	var r1 *kms.UpdateAliasOutput
+	if rf, ok := ret.Get(1).(func(*kms.UpdateAliasInput) *kms.UpdateAliasOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.RevokeGrantOutput)
+			r1 = ret.Get(1).(*kms.UpdateAliasOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// RevokeGrantWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) RevokeGrantWithContext(_a0 aws.Context, _a1 *kms.RevokeGrantInput, _a2 ...request.Option) (*kms.RevokeGrantOutput, error) {
+// UpdateAliasWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) UpdateAliasWithContext(_a0 aws.Context, _a1 *kms.UpdateAliasInput, _a2 ...request.Option) (*kms.UpdateAliasOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2473,17 +4017,17 @@ func (_m *KMSAPI) RevokeGrantWithContext(_a0 aws.Context, _a1 *kms.RevokeGrantIn
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.RevokeGrantOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.RevokeGrantInput, ...request.Option) *kms.RevokeGrantOutput); ok {
+	var r0 *kms.UpdateAliasOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.UpdateAliasInput, ...request.Option) *kms.UpdateAliasOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.RevokeGrantOutput)
+			r0 = ret.Get(0).(*kms.UpdateAliasOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.RevokeGrantInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.UpdateAliasInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2492,21 +4036,21 @@ func (_m *KMSAPI) RevokeGrantWithContext(_a0 aws.Context, _a1 *kms.RevokeGrantIn
 	return r0, r1
 }
 
-// ScheduleKeyDeletion provides a mock function with given fields: _a0
-func (_m *KMSAPI) ScheduleKeyDeletion(_a0 *kms.ScheduleKeyDeletionInput) (*kms.ScheduleKeyDeletionOutput, error) {
+// UpdateCustomKeyStore provides a mock function with given fields: _a0
+func (_m *KMSAPI) UpdateCustomKeyStore(_a0 *kms.UpdateCustomKeyStoreInput) (*kms.UpdateCustomKeyStoreOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.ScheduleKeyDeletionOutput
-	if rf, ok := ret.Get(0).(func(*kms.ScheduleKeyDeletionInput) *kms.ScheduleKeyDeletionOutput); ok {
+	var r0 *kms.UpdateCustomKeyStoreOutput
+	if rf, ok := ret.Get(0).(func(*kms.UpdateCustomKeyStoreInput) *kms.UpdateCustomKeyStoreOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.ScheduleKeyDeletionOutput)
+			r0 = ret.Get(0).(*kms.UpdateCustomKeyStoreOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.ScheduleKeyDeletionInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.UpdateCustomKeyStoreInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2515,12 +4059,12 @@ func (_m *KMSAPI) ScheduleKeyDeletion(_a0 *kms.ScheduleKeyDeletionInput) (*kms.S
 	return r0, r1
 }
 
-// ScheduleKeyDeletionRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) ScheduleKeyDeletionRequest(_a0 *kms.ScheduleKeyDeletionInput) (*request.Request, *kms.ScheduleKeyDeletionOutput) {
+// UpdateCustomKeyStoreRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) UpdateCustomKeyStoreRequest(_a0 *kms.UpdateCustomKeyStoreInput) (*request.Request, *kms.UpdateCustomKeyStoreOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.ScheduleKeyDeletionInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.UpdateCustomKeyStoreInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2528,20 +4072,20 @@ func (_m *KMSAPI) ScheduleKeyDeletionRequest(_a0 *kms.ScheduleKeyDeletionInput)
 		}
 	}
 
-	var r1 *kms.ScheduleKeyDeletionOutput
-	if rf, ok := ret.Get(1).(func(*kms.ScheduleKeyDeletionInput) *kms.ScheduleKeyDeletionOutput); ok {
+	var r1 *kms.UpdateCustomKeyStoreOutput
+	if rf, ok := ret.Get(1).(func(*kms.UpdateCustomKeyStoreInput) *kms.UpdateCustomKeyStoreOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.ScheduleKeyDeletionOutput)
+			r1 = ret.Get(1).(*kms.UpdateCustomKeyStoreOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ScheduleKeyDeletionWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) ScheduleKeyDeletionWithContext(_a0 aws.Context, _a1 *kms.ScheduleKeyDeletionInput, _a2 ...request.Option) (*kms.ScheduleKeyDeletionOutput, error) {
+// UpdateCustomKeyStoreWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) UpdateCustomKeyStoreWithContext(_a0 aws.Context, _a1 *kms.UpdateCustomKeyStoreInput, _a2 ...request.Option) (*kms.UpdateCustomKeyStoreOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2551,17 +4095,17 @@ func (_m *KMSAPI) ScheduleKeyDeletionWithContext(_a0 aws.Context, _a1 *kms.Sched
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.ScheduleKeyDeletionOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.ScheduleKeyDeletionInput, ...request.Option) *kms.ScheduleKeyDeletionOutput); ok {
+	var r0 *kms.UpdateCustomKeyStoreOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.UpdateCustomKeyStoreInput, ...request.Option) *kms.UpdateCustomKeyStoreOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.ScheduleKeyDeletionOutput)
+			r0 = ret.Get(0).(*kms.UpdateCustomKeyStoreOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.ScheduleKeyDeletionInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.UpdateCustomKeyStoreInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2570,21 +4114,21 @@ func (_m *KMSAPI) ScheduleKeyDeletionWithContext(_a0 aws.Context, _a1 *kms.Sched
 	return r0, r1
 }
 
-// TagResource provides a mock function with given fields: _a0
-func (_m *KMSAPI) TagResource(_a0 *kms.TagResourceInput) (*kms.TagResourceOutput, error) {
+// UpdateKeyDescription provides a mock function with given fields: _a0
+func (_m *KMSAPI) UpdateKeyDescription(_a0 *kms.UpdateKeyDescriptionInput) (*kms.UpdateKeyDescriptionOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.TagResourceOutput
-	if rf, ok := ret.Get(0).(func(*kms.TagResourceInput) *kms.TagResourceOutput); ok {
+	var r0 *kms.UpdateKeyDescriptionOutput
+	if rf, ok := ret.Get(0).(func(*kms.UpdateKeyDescriptionInput) *kms.UpdateKeyDescriptionOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.TagResourceOutput)
+			r0 = ret.Get(0).(*kms.UpdateKeyDescriptionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.TagResourceInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.UpdateKeyDescriptionInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2593,12 +4137,12 @@ func (_m *KMSAPI) TagResource(_a0 *kms.TagResourceInput) (*kms.TagResourceOutput
 	return r0, r1
 }
 
-// TagResourceRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) TagResourceRequest(_a0 *kms.TagResourceInput) (*request.Request, *kms.TagResourceOutput) {
+// UpdateKeyDescriptionRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) UpdateKeyDescriptionRequest(_a0 *kms.UpdateKeyDescriptionInput) (*request.Request, *kms.UpdateKeyDescriptionOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.TagResourceInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.UpdateKeyDescriptionInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2606,20 +4150,20 @@ func (_m *KMSAPI) TagResourceRequest(_a0 *kms.TagResourceInput) (*request.Reques
 		}
 	}
 
-	var r1 *kms.TagResourceOutput
-	if rf, ok := ret.Get(1).(func(*kms.TagResourceInput) *kms.TagResourceOutput); ok {
+	var r1 *kms.UpdateKeyDescriptionOutput
+	if rf, ok := ret.Get(1).(func(*kms.UpdateKeyDescriptionInput) *kms.UpdateKeyDescriptionOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.TagResourceOutput)
+			r1 = ret.Get(1).(*kms.UpdateKeyDescriptionOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// TagResourceWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) TagResourceWithContext(_a0 aws.Context, _a1 *kms.TagResourceInput, _a2 ...request.Option) (*kms.TagResourceOutput, error) {
+// UpdateKeyDescriptionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) UpdateKeyDescriptionWithContext(_a0 aws.Context, _a1 *kms.UpdateKeyDescriptionInput, _a2 ...request.Option) (*kms.UpdateKeyDescriptionOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2629,17 +4173,17 @@ func (_m *KMSAPI) TagResourceWithContext(_a0 aws.Context, _a1 *kms.TagResourceIn
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.TagResourceOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.TagResourceInput, ...request.Option) *kms.TagResourceOutput); ok {
+	var r0 *kms.UpdateKeyDescriptionOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.UpdateKeyDescriptionInput, ...request.Option) *kms.UpdateKeyDescriptionOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.TagResourceOutput)
+			r0 = ret.Get(0).(*kms.UpdateKeyDescriptionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.TagResourceInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.UpdateKeyDescriptionInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2648,21 +4192,21 @@ func (_m *KMSAPI) TagResourceWithContext(_a0 aws.Context, _a1 *kms.TagResourceIn
 	return r0, r1
 }
 
-// UntagResource provides a mock function with given fields: _a0
-func (_m *KMSAPI) UntagResource(_a0 *kms.UntagResourceInput) (*kms.UntagResourceOutput, error) {
+// UpdatePrimaryRegion provides a mock function with given fields: _a0
+func (_m *KMSAPI) UpdatePrimaryRegion(_a0 *kms.UpdatePrimaryRegionInput) (*kms.UpdatePrimaryRegionOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.UntagResourceOutput
-	if rf, ok := ret.Get(0).(func(*kms.UntagResourceInput) *kms.UntagResourceOutput); ok {
+	var r0 *kms.UpdatePrimaryRegionOutput
+	if rf, ok := ret.Get(0).(func(*kms.UpdatePrimaryRegionInput) *kms.UpdatePrimaryRegionOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.UntagResourceOutput)
+			r0 = ret.Get(0).(*kms.UpdatePrimaryRegionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.UntagResourceInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.UpdatePrimaryRegionInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2671,12 +4215,12 @@ func (_m *KMSAPI) UntagResource(_a0 *kms.UntagResourceInput) (*kms.UntagResource
 	return r0, r1
 }
 
-// UntagResourceRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) UntagResourceRequest(_a0 *kms.UntagResourceInput) (*request.Request, *kms.UntagResourceOutput) {
+// UpdatePrimaryRegionRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) UpdatePrimaryRegionRequest(_a0 *kms.UpdatePrimaryRegionInput) (*request.Request, *kms.UpdatePrimaryRegionOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.UntagResourceInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.UpdatePrimaryRegionInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2684,20 +4228,20 @@ func (_m *KMSAPI) UntagResourceRequest(_a0 *kms.UntagResourceInput) (*request.Re
 		}
 	}
 
-	var r1 *kms.UntagResourceOutput
-	if rf, ok := ret.Get(1).(func(*kms.UntagResourceInput) *kms.UntagResourceOutput); ok {
+	var r1 *kms.UpdatePrimaryRegionOutput
+	if rf, ok := ret.Get(1).(func(*kms.UpdatePrimaryRegionInput) *kms.UpdatePrimaryRegionOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.UntagResourceOutput)
+			r1 = ret.Get(1).(*kms.UpdatePrimaryRegionOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// UntagResourceWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) UntagResourceWithContext(_a0 aws.Context, _a1 *kms.UntagResourceInput, _a2 ...request.Option) (*kms.UntagResourceOutput, error) {
+// UpdatePrimaryRegionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) UpdatePrimaryRegionWithContext(_a0 aws.Context, _a1 *kms.UpdatePrimaryRegionInput, _a2 ...request.Option) (*kms.UpdatePrimaryRegionOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2707,17 +4251,17 @@ func (_m *KMSAPI) UntagResourceWithContext(_a0 aws.Context, _a1 *kms.UntagResour
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.UntagResourceOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.UntagResourceInput, ...request.Option) *kms.UntagResourceOutput); ok {
+	var r0 *kms.UpdatePrimaryRegionOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.UpdatePrimaryRegionInput, ...request.Option) *kms.UpdatePrimaryRegionOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.UntagResourceOutput)
+			r0 = ret.Get(0).(*kms.UpdatePrimaryRegionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.UntagResourceInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.UpdatePrimaryRegionInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2726,21 +4270,21 @@ func (_m *KMSAPI) UntagResourceWithContext(_a0 aws.Context, _a1 *kms.UntagResour
 	return r0, r1
 }
 
-// UpdateAlias provides a mock function with given fields: _a0
-func (_m *KMSAPI) UpdateAlias(_a0 *kms.UpdateAliasInput) (*kms.UpdateAliasOutput, error) {
+// Verify provides a mock function with given fields: _a0
+func (_m *KMSAPI) Verify(_a0 *kms.VerifyInput) (*kms.VerifyOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *kms.UpdateAliasOutput
-	if rf, ok := ret.Get(0).(func(*kms.UpdateAliasInput) *kms.UpdateAliasOutput); ok {
+	var r0 *kms.VerifyOutput
+	if rf, ok := ret.Get(0).(func(*kms.VerifyInput) *kms.VerifyOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.UpdateAliasOutput)
+			r0 = ret.Get(0).(*kms.VerifyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.UpdateAliasInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*kms.VerifyInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2749,12 +4293,35 @@ func (_m *KMSAPI) UpdateAlias(_a0 *kms.UpdateAliasInput) (*kms.UpdateAliasOutput
 	return r0, r1
 }
 
-// UpdateAliasRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) UpdateAliasRequest(_a0 *kms.UpdateAliasInput) (*request.Request, *kms.UpdateAliasOutput) {
+// VerifyMac provides a mock function with given fields: _a0
+func (_m *KMSAPI) VerifyMac(_a0 *kms.VerifyMacInput) (*kms.VerifyMacOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *kms.VerifyMacOutput
+	if rf, ok := ret.Get(0).(func(*kms.VerifyMacInput) *kms.VerifyMacOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kms.VerifyMacOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*kms.VerifyMacInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VerifyMacRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) VerifyMacRequest(_a0 *kms.VerifyMacInput) (*request.Request, *kms.VerifyMacOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.UpdateAliasInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.VerifyMacInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2762,20 +4329,20 @@ func (_m *KMSAPI) UpdateAliasRequest(_a0 *kms.UpdateAliasInput) (*request.Reques
 		}
 	}
 
-	var r1 *kms.UpdateAliasOutput
-	if rf, ok := ret.Get(1).(func(*kms.UpdateAliasInput) *kms.UpdateAliasOutput); ok {
+	var r1 *kms.VerifyMacOutput
+	if rf, ok := ret.Get(1).(func(*kms.VerifyMacInput) *kms.VerifyMacOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.UpdateAliasOutput)
+			r1 = ret.Get(1).(*kms.VerifyMacOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// UpdateAliasWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) UpdateAliasWithContext(_a0 aws.Context, _a1 *kms.UpdateAliasInput, _a2 ...request.Option) (*kms.UpdateAliasOutput, error) {
+// VerifyMacWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) VerifyMacWithContext(_a0 aws.Context, _a1 *kms.VerifyMacInput, _a2 ...request.Option) (*kms.VerifyMacOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2785,17 +4352,17 @@ func (_m *KMSAPI) UpdateAliasWithContext(_a0 aws.Context, _a1 *kms.UpdateAliasIn
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.UpdateAliasOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.UpdateAliasInput, ...request.Option) *kms.UpdateAliasOutput); ok {
+	var r0 *kms.VerifyMacOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.VerifyMacInput, ...request.Option) *kms.VerifyMacOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.UpdateAliasOutput)
+			r0 = ret.Get(0).(*kms.VerifyMacOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.UpdateAliasInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.VerifyMacInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2804,35 +4371,12 @@ func (_m *KMSAPI) UpdateAliasWithContext(_a0 aws.Context, _a1 *kms.UpdateAliasIn
 	return r0, r1
 }
 
-// UpdateKeyDescription provides a mock function with given fields: _a0
-func (_m *KMSAPI) UpdateKeyDescription(_a0 *kms.UpdateKeyDescriptionInput) (*kms.UpdateKeyDescriptionOutput, error) {
-	ret := _m.Called(_a0)
-
-	var r0 *kms.UpdateKeyDescriptionOutput
-	if rf, ok := ret.Get(0).(func(*kms.UpdateKeyDescriptionInput) *kms.UpdateKeyDescriptionOutput); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.UpdateKeyDescriptionOutput)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(*kms.UpdateKeyDescriptionInput) error); ok {
-		r1 = rf(_a0)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// UpdateKeyDescriptionRequest provides a mock function with given fields: _a0
-func (_m *KMSAPI) UpdateKeyDescriptionRequest(_a0 *kms.UpdateKeyDescriptionInput) (*request.Request, *kms.UpdateKeyDescriptionOutput) {
+// VerifyRequest provides a mock function with given fields: _a0
+func (_m *KMSAPI) VerifyRequest(_a0 *kms.VerifyInput) (*request.Request, *kms.VerifyOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*kms.UpdateKeyDescriptionInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*kms.VerifyInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2840,20 +4384,20 @@ func (_m *KMSAPI) UpdateKeyDescriptionRequest(_a0 *kms.UpdateKeyDescriptionInput
 		}
 	}
 
-	var r1 *kms.UpdateKeyDescriptionOutput
-	if rf, ok := ret.Get(1).(func(*kms.UpdateKeyDescriptionInput) *kms.UpdateKeyDescriptionOutput); ok {
+	var r1 *kms.VerifyOutput
+	if rf, ok := ret.Get(1).(func(*kms.VerifyInput) *kms.VerifyOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*kms.UpdateKeyDescriptionOutput)
+			r1 = ret.Get(1).(*kms.VerifyOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// UpdateKeyDescriptionWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *KMSAPI) UpdateKeyDescriptionWithContext(_a0 aws.Context, _a1 *kms.UpdateKeyDescriptionInput, _a2 ...request.Option) (*kms.UpdateKeyDescriptionOutput, error) {
+// VerifyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KMSAPI) VerifyWithContext(_a0 aws.Context, _a1 *kms.VerifyInput, _a2 ...request.Option) (*kms.VerifyOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2863,17 +4407,17 @@ func (_m *KMSAPI) UpdateKeyDescriptionWithContext(_a0 aws.Context, _a1 *kms.Upda
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *kms.UpdateKeyDescriptionOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *kms.UpdateKeyDescriptionInput, ...request.Option) *kms.UpdateKeyDescriptionOutput); ok {
+	var r0 *kms.VerifyOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *kms.VerifyInput, ...request.Option) *kms.VerifyOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*kms.UpdateKeyDescriptionOutput)
+			r0 = ret.Get(0).(*kms.VerifyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *kms.UpdateKeyDescriptionInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *kms.VerifyInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
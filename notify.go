@@ -0,0 +1,272 @@
+package unicreds
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sns/snsiface"
+)
+
+const (
+	// NotifyPut a version of the secret was stored
+	NotifyPut = "put"
+	// NotifyRollback a previous version of the secret was copied forward
+	NotifyRollback = "rollback"
+	// NotifyDelete every version of the secret was deleted
+	NotifyDelete = "delete"
+
+	// NotifyOnFailureWarn log failed notifications, the default
+	NotifyOnFailureWarn = "warn"
+	// NotifyOnFailureFail return an error for failed notifications, the change has still been made
+	NotifyOnFailureFail = "fail"
+
+	// NotifyFormatSlack post a message which a Slack incoming webhook can display
+	NotifyFormatSlack = "slack"
+
+	// SignatureHeader the HMAC-SHA256 of the webhook body, hex encoded with a sha256= prefix
+	SignatureHeader = "X-Unicreds-Signature"
+
+	webhookTimeout = 10 * time.Second
+)
+
+var (
+	snsSvc snsiface.SNSAPI
+
+	webhookClient = &http.Client{Timeout: webhookTimeout}
+
+	notificationHooks []*NotificationHook
+
+	notifyActorOnce sync.Once
+	notifyActor     string
+)
+
+func init() {
+	snsSvc = sns.New(session.New(), aws.NewConfig())
+}
+
+// SetSNSSession override the default aws session
+func SetSNSSession(sess *session.Session) {
+	snsSvc = sns.New(sess)
+}
+
+// NotificationHook a webhook or SNS topic notified after secrets whose names start with the
+// prefix are stored or deleted
+type NotificationHook struct {
+	Prefix string `yaml:"prefix"`
+
+	// Webhook URL the notification is posted to as JSON
+	Webhook string `yaml:"webhook"`
+	// SecretEnv environment variable holding the key used to sign webhook bodies, required
+	// for webhooks so receivers can verify the notification came from unicreds
+	SecretEnv string `yaml:"secret_env"`
+	// Format either json, the default, or slack
+	Format string `yaml:"format"`
+
+	// SNSTopic ARN of the topic the notification is published to as JSON
+	SNSTopic string `yaml:"sns_topic"`
+
+	// OnFailure either warn, the default, or fail
+	OnFailure string `yaml:"on_failure"`
+}
+
+// Notification describes a change to a secret, it never contains the secret
+type Notification struct {
+	Name    string    `json:"name"`
+	Version string    `json:"version,omitempty"`
+	Action  string    `json:"action"`
+	Actor   string    `json:"actor,omitempty"`
+	Table   string    `json:"table"`
+	Time    time.Time `json:"time"`
+}
+
+// NotificationError returned when a hook with the fail policy couldn't be notified, the
+// change to the secret has already been made
+type NotificationError struct {
+	Name   string
+	Action string
+	Errors []error
+}
+
+func (e *NotificationError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		msgs = append(msgs, err.Error())
+	}
+	return fmt.Sprintf("%s of secret %s succeeded but notifications failed: %s", e.Action, e.Name, strings.Join(msgs, "; "))
+}
+
+func (h *NotificationHook) validate() error {
+	if (h.Webhook == "") == (h.SNSTopic == "") {
+		return fmt.Errorf("notification for %q must have exactly one of webhook or sns_topic", h.Prefix)
+	}
+
+	if h.Webhook != "" {
+		u, err := url.Parse(h.Webhook)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return fmt.Errorf("notification for %q has an invalid webhook URL", h.Prefix)
+		}
+		if h.SecretEnv == "" {
+			return fmt.Errorf("notification for %q must set secret_env to the environment variable holding the webhook signing key", h.Prefix)
+		}
+	} else if h.SecretEnv != "" || h.Format != "" {
+		return fmt.Errorf("notification for %q can only set secret_env and format for webhooks", h.Prefix)
+	}
+
+	switch h.Format {
+	case "", "json", NotifyFormatSlack:
+	default:
+		return fmt.Errorf("notification for %q has an unknown format %q", h.Prefix, h.Format)
+	}
+
+	switch h.OnFailure {
+	case "", NotifyOnFailureWarn, NotifyOnFailureFail:
+	default:
+		return fmt.Errorf("notification for %q has an unknown on_failure %q", h.Prefix, h.OnFailure)
+	}
+
+	return nil
+}
+
+// SetNotificationHooks set the hooks notified after secrets are stored or deleted
+func SetNotificationHooks(hooks []*NotificationHook) {
+	notificationHooks = hooks
+	notifyActorOnce = sync.Once{}
+}
+
+// notify send a notification to each hook whose prefix matches the name
+func notify(tableName *string, action, name, version string) error {
+	var failed []error
+
+	for _, hook := range notificationHooks {
+		if !strings.HasPrefix(name, hook.Prefix) {
+			continue
+		}
+
+		n := &Notification{
+			Name:    name,
+			Version: version,
+			Action:  action,
+			Actor:   actor(),
+			Table:   aws.StringValue(tableName),
+			Time:    time.Now().UTC(),
+		}
+
+		err := hook.send(n)
+		if err == nil {
+			continue
+		}
+
+		if hook.OnFailure == NotifyOnFailureFail {
+			failed = append(failed, err)
+		} else {
+			log.WithError(err).WithField("name", name).Warn("Notification failed")
+		}
+	}
+
+	if len(failed) > 0 {
+		return &NotificationError{Name: name, Action: action, Errors: failed}
+	}
+
+	return nil
+}
+
+// actor the identity making changes, looked up once as it's included in every notification
+func actor() string {
+	notifyActorOnce.Do(func() {
+		arn, err := GetCallerIdentity()
+		if err != nil {
+			log.WithError(err).Debug("Unable to look up the caller for notifications")
+		}
+		notifyActor = arn
+	})
+	return notifyActor
+}
+
+func (h *NotificationHook) send(n *Notification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+
+	if h.SNSTopic != "" {
+		_, err = snsSvc.Publish(&sns.PublishInput{
+			TopicArn: aws.String(h.SNSTopic),
+			Message:  aws.String(string(body)),
+			MessageAttributes: map[string]*sns.MessageAttributeValue{
+				"action": {DataType: aws.String("String"), StringValue: aws.String(n.Action)},
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("sns topic %s: %v", h.SNSTopic, err)
+		}
+		return nil
+	}
+
+	if h.Format == NotifyFormatSlack {
+		if body, err = json.Marshal(map[string]string{"text": n.slackText()}); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequest(http.MethodPost, h.Webhook, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	key := os.Getenv(h.SecretEnv)
+	if key == "" {
+		return fmt.Errorf("webhook signing key %s is not set", h.SecretEnv)
+	}
+	req.Header.Set(SignatureHeader, SignWebhook([]byte(key), body))
+
+	// errors and messages only include the host, webhook URLs often contain a token
+	res, err := webhookClient.Do(req)
+	if err != nil {
+		if urlErr, ok := err.(*url.Error); ok {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("webhook %s: %v", req.URL.Host, err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("webhook %s: %s", req.URL.Host, res.Status)
+	}
+
+	return nil
+}
+
+func (n *Notification) slackText() string {
+	text := fmt.Sprintf("%s %s", n.Action, n.Name)
+	if n.Version != "" {
+		text += " version " + n.Version
+	}
+	text += " in " + n.Table
+	if n.Actor != "" {
+		text += " by " + n.Actor
+	}
+	return text
+}
+
+// SignWebhook returns the signature header value for the body, receivers should compute the
+// same value with their copy of the key and compare them in constant time
+func SignWebhook(key, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,123 @@
+package unicreds
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/versent/unicreds/mocks"
+)
+
+func configureNotifyMock(hooks ...*NotificationHook) *mocks.SNSAPI {
+	stsMock := &mocks.STSAPI{}
+	snsMock := &mocks.SNSAPI{}
+
+	stsSvc = stsMock
+	snsSvc = snsMock
+
+	stsMock.On("GetCallerIdentity", mock.AnythingOfType("*sts.GetCallerIdentityInput")).Return(&sts.GetCallerIdentityOutput{
+		Arn: aws.String("arn:aws:iam::123456789012:user/bob"),
+	}, nil)
+
+	SetNotificationHooks(hooks)
+
+	return snsMock
+}
+
+func TestNotifyWebhook(t *testing.T) {
+	os.Setenv("UNICREDS_TEST_WEBHOOK_KEY", "key")
+	defer os.Unsetenv("UNICREDS_TEST_WEBHOOK_KEY")
+
+	var body []byte
+	var signature string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = ioutil.ReadAll(r.Body)
+		signature = r.Header.Get(SignatureHeader)
+	}))
+	defer server.Close()
+
+	configureNotifyMock(&NotificationHook{Prefix: "prod/", Webhook: server.URL, SecretEnv: "UNICREDS_TEST_WEBHOOK_KEY"})
+	defer SetNotificationHooks(nil)
+
+	err := notify(&tableName, NotifyPut, "prod/db", PaddedInt(2))
+	assert.Nil(t, err)
+
+	n := new(Notification)
+	assert.Nil(t, json.Unmarshal(body, n))
+	assert.Equal(t, "prod/db", n.Name)
+	assert.Equal(t, PaddedInt(2), n.Version)
+	assert.Equal(t, NotifyPut, n.Action)
+	assert.Equal(t, "arn:aws:iam::123456789012:user/bob", n.Actor)
+	assert.Equal(t, SignWebhook([]byte("key"), body), signature)
+
+	// other prefixes aren't sent
+	body = nil
+	assert.Nil(t, notify(&tableName, NotifyPut, "dev/db", PaddedInt(2)))
+	assert.Nil(t, body)
+}
+
+func TestNotifyFailurePolicy(t *testing.T) {
+	os.Setenv("UNICREDS_TEST_WEBHOOK_KEY", "key")
+	defer os.Unsetenv("UNICREDS_TEST_WEBHOOK_KEY")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	configureNotifyMock(&NotificationHook{Webhook: server.URL, SecretEnv: "UNICREDS_TEST_WEBHOOK_KEY"})
+	defer SetNotificationHooks(nil)
+
+	assert.Nil(t, notify(&tableName, NotifyDelete, "test", ""))
+
+	configureNotifyMock(&NotificationHook{Webhook: server.URL, SecretEnv: "UNICREDS_TEST_WEBHOOK_KEY", OnFailure: NotifyOnFailureFail})
+
+	err := notify(&tableName, NotifyDelete, "test", "")
+	assert.IsType(t, &NotificationError{}, err)
+	assert.Contains(t, err.Error(), "500")
+}
+
+func TestNotifySNS(t *testing.T) {
+	snsMock := configureNotifyMock(&NotificationHook{SNSTopic: "arn:aws:sns:us-west-2:123456789012:secrets"})
+	defer SetNotificationHooks(nil)
+
+	snsMock.On("Publish", mock.MatchedBy(func(in *sns.PublishInput) bool {
+		n := new(Notification)
+		return json.Unmarshal([]byte(aws.StringValue(in.Message)), n) == nil && n.Name == "test" && n.Action == NotifyRollback
+	})).Return(&sns.PublishOutput{}, nil)
+
+	assert.Nil(t, notify(&tableName, NotifyRollback, "test", PaddedInt(3)))
+	snsMock.AssertNumberOfCalls(t, "Publish", 1)
+}
+
+func TestPutSecretNotifies(t *testing.T) {
+	snsMock := configureNotifyMock(&NotificationHook{SNSTopic: "arn:aws:sns:us-west-2:123456789012:secrets"})
+	defer SetNotificationHooks(nil)
+
+	dsMock, kmsMock := configureMock()
+
+	dko := &kms.GenerateDataKeyOutput{
+		Plaintext:      append(append([]byte{}, dsPlainText...), dsPlainText...),
+		CiphertextBlob: []byte("wrapped"),
+	}
+
+	kmsMock.On("GenerateDataKey", mock.AnythingOfType("*kms.GenerateDataKeyInput")).Return(dko, nil)
+	dsMock.On("PutItem", mock.AnythingOfType("*dynamodb.PutItemInput")).Return(&dynamodb.PutItemOutput{}, nil)
+	snsMock.On("Publish", mock.AnythingOfType("*sns.PublishInput")).Return(&sns.PublishOutput{}, nil)
+
+	err := PutSecret(&tableName, "", "test", "secret", PaddedInt(1), NewEncryptionContextValue())
+
+	assert.Nil(t, err)
+	snsMock.AssertNumberOfCalls(t, "Publish", 1)
+}
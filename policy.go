@@ -0,0 +1,151 @@
+package unicreds
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"unicode"
+)
+
+const (
+	// ClassLower lower case letters
+	ClassLower = "lower"
+	// ClassUpper upper case letters
+	ClassUpper = "upper"
+	// ClassDigit digits
+	ClassDigit = "digit"
+	// ClassSymbol anything which isn't a letter or digit
+	ClassSymbol = "symbol"
+)
+
+// classPoolSizes number of characters in each class used to estimate entropy
+var classPoolSizes = map[string]int{
+	ClassLower:  26,
+	ClassUpper:  26,
+	ClassDigit:  10,
+	ClassSymbol: 33,
+}
+
+// PasswordPolicy requirements for the values of secrets whose names start with the prefix
+type PasswordPolicy struct {
+	Prefix          string   `yaml:"prefix"`
+	MinLength       int      `yaml:"min_length"`
+	RequiredClasses []string `yaml:"required_classes"`
+	MinEntropy      float64  `yaml:"min_entropy"`
+	DenyList        []string `yaml:"deny_list"`
+	DenyListFile    string   `yaml:"deny_list_file"`
+}
+
+// PasswordPolicyError returned when a secret doesn't meet the password policy for its name
+type PasswordPolicyError struct {
+	Name       string
+	Violations []string
+}
+
+func (e *PasswordPolicyError) Error() string {
+	return fmt.Sprintf("secret %s doesn't meet the password policy: %s", e.Name, strings.Join(e.Violations, "; "))
+}
+
+func (p *PasswordPolicy) validate() error {
+	for _, class := range p.RequiredClasses {
+		if _, ok := classPoolSizes[class]; !ok {
+			return fmt.Errorf("password policy for %q has an unknown character class %q", p.Prefix, class)
+		}
+	}
+	return nil
+}
+
+// Check returns a description of each of the requirements the secret doesn't meet
+func (p *PasswordPolicy) Check(secret string) ([]string, error) {
+	var violations []string
+
+	if length := len([]rune(secret)); length < p.MinLength {
+		violations = append(violations, fmt.Sprintf("must be at least %d characters, got %d", p.MinLength, length))
+	}
+
+	classes := characterClasses(secret)
+	for _, class := range p.RequiredClasses {
+		if !classes[class] {
+			violations = append(violations, fmt.Sprintf("must contain a %s character", class))
+		}
+	}
+
+	if p.MinEntropy > 0 {
+		if entropy := EstimateEntropy(secret); entropy < p.MinEntropy {
+			violations = append(violations, fmt.Sprintf("estimated entropy of %.0f bits is below %.0f", entropy, p.MinEntropy))
+		}
+	}
+
+	denied, err := p.denied(secret)
+	if err != nil {
+		return nil, err
+	}
+	if denied {
+		violations = append(violations, "is on the deny list")
+	}
+
+	return violations, nil
+}
+
+// denied check the secret against the deny list and deny list file, ignoring case
+func (p *PasswordPolicy) denied(secret string) (bool, error) {
+	for _, word := range p.DenyList {
+		if strings.EqualFold(word, secret) {
+			return true, nil
+		}
+	}
+
+	if p.DenyListFile == "" {
+		return false, nil
+	}
+
+	file, err := os.Open(p.DenyListFile)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if strings.EqualFold(strings.TrimSpace(scanner.Text()), secret) {
+			return true, nil
+		}
+	}
+
+	return false, scanner.Err()
+}
+
+func characterClasses(secret string) map[string]bool {
+	classes := map[string]bool{}
+	for _, r := range secret {
+		switch {
+		case unicode.IsLower(r):
+			classes[ClassLower] = true
+		case unicode.IsUpper(r):
+			classes[ClassUpper] = true
+		case unicode.IsDigit(r):
+			classes[ClassDigit] = true
+		default:
+			classes[ClassSymbol] = true
+		}
+	}
+	return classes
+}
+
+// EstimateEntropy estimate the bits of entropy in the secret from its length and the size of
+// the character classes it uses, this assumes the characters were chosen at random so it
+// overestimates the strength of words and patterns
+func EstimateEntropy(secret string) float64 {
+	pool := 0
+	for class := range characterClasses(secret) {
+		pool += classPoolSizes[class]
+	}
+
+	if pool == 0 {
+		return 0
+	}
+
+	return float64(len([]rune(secret))) * math.Log2(float64(pool))
+}
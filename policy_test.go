@@ -0,0 +1,34 @@
+package unicreds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordPolicyCheck(t *testing.T) {
+	policy := &PasswordPolicy{
+		MinLength:       12,
+		RequiredClasses: []string{ClassLower, ClassUpper, ClassDigit, ClassSymbol},
+		MinEntropy:      60,
+		DenyList:        []string{"Password123!"},
+	}
+
+	violations, err := policy.Check("password123")
+	assert.Nil(t, err)
+	assert.Len(t, violations, 4)
+
+	violations, err = policy.Check("password123!")
+	assert.Nil(t, err)
+	assert.Contains(t, violations, "is on the deny list")
+
+	violations, err = policy.Check("c0rrect-Horse-battery")
+	assert.Nil(t, err)
+	assert.Empty(t, violations)
+}
+
+func TestEstimateEntropy(t *testing.T) {
+	assert.Equal(t, float64(0), EstimateEntropy(""))
+	assert.InDelta(t, 8*4.7, EstimateEntropy("abcdefgh"), 0.1)
+	assert.True(t, EstimateEntropy("aB3$aB3$") > EstimateEntropy("abcdefgh"))
+}
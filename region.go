@@ -0,0 +1,213 @@
+package unicreds
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+const (
+	// DefaultMetadataEndpoint default EC2 instance metadata service endpoint
+	DefaultMetadataEndpoint = "http://169.254.169.254"
+
+	// RegionSourceFlag region was supplied as a flag
+	RegionSourceFlag = "flag"
+	// RegionSourceEnv region was read from AWS_REGION or AWS_DEFAULT_REGION
+	RegionSourceEnv = "environment"
+	// RegionSourceProfile region was read from the shared aws config
+	RegionSourceProfile = "profile"
+	// RegionSourceIMDS region was read from the EC2 instance metadata service
+	RegionSourceIMDS = "instance metadata"
+	// RegionSourceECS region was read from the ECS task metadata endpoint
+	RegionSourceECS = "task metadata"
+
+	metadataTimeout  = 2 * time.Second
+	metadataTokenTTL = "21600"
+)
+
+var metadataEndpoint = DefaultMetadataEndpoint
+
+func init() {
+	if endpoint := os.Getenv("AWS_EC2_METADATA_SERVICE_ENDPOINT"); endpoint != "" {
+		SetMetadataEndpoint(endpoint)
+	}
+}
+
+// SetMetadataEndpoint override the EC2 instance metadata endpoint used for region discovery
+func SetMetadataEndpoint(endpoint string) {
+	metadataEndpoint = strings.TrimRight(endpoint, "/")
+}
+
+// ResolveRegion discover the region by checking in order the supplied flag, the
+// AWS_REGION and AWS_DEFAULT_REGION environment variables, the region of the shared config
+// profile, EC2 instance metadata and ECS task metadata. Returns the region and where it
+// was found, or an empty region if none of the sources supplied one.
+func ResolveRegion(region, profile string) (string, string, error) {
+	if region != "" {
+		return region, RegionSourceFlag, nil
+	}
+
+	for _, name := range []string{"AWS_REGION", "AWS_DEFAULT_REGION"} {
+		if r := os.Getenv(name); r != "" {
+			return r, RegionSourceEnv, nil
+		}
+	}
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		SharedConfigState: session.SharedConfigEnable,
+		Profile:           profile,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	if r := aws.StringValue(sess.Config.Region); r != "" {
+		return r, RegionSourceProfile, nil
+	}
+
+	if r, err := getInstanceRegion(); err != nil {
+		log.WithField("err", err).Debug("Request instance region")
+	} else if r != "" {
+		return r, RegionSourceIMDS, nil
+	}
+
+	if r, err := getTaskRegion(); err != nil {
+		log.WithField("err", err).Debug("Request task region")
+	} else if r != "" {
+		return r, RegionSourceECS, nil
+	}
+
+	return "", "", nil
+}
+
+// getInstanceRegion ask the instance metadata service for our region, using an IMDSv2
+// session token and falling back to IMDSv1 if a token can't be issued
+func getInstanceRegion() (string, error) {
+	if strings.EqualFold(os.Getenv("AWS_EC2_METADATA_DISABLED"), "true") {
+		return "", nil
+	}
+
+	client := &http.Client{Timeout: metadataTimeout}
+
+	token, err := getMetadataToken(client)
+	if err != nil {
+		return "", err
+	}
+
+	region, err := getMetadata(client, token, "/latest/meta-data/placement/region")
+	if err != nil || region != "" {
+		return region, err
+	}
+
+	// older metadata services only expose the availability zone
+	zone, err := getMetadata(client, token, "/latest/meta-data/placement/availability-zone")
+	if err != nil || len(zone) < 2 {
+		return "", err
+	}
+
+	return strings.TrimRight(zone, "abcdefghijklmnopqrstuvwxyz"), nil
+}
+
+func getMetadataToken(client *http.Client) (string, error) {
+	req, err := http.NewRequest(http.MethodPut, metadataEndpoint+"/latest/api/token", nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-aws-ec2-metadata-token-ttl-seconds", metadataTokenTTL)
+
+	res, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		// IMDSv1 only, continue without a token
+		log.WithField("status", res.StatusCode).Debug("Metadata token unavailable")
+		return "", nil
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(body)), nil
+}
+
+// getMetadata returns an empty string if the path doesn't exist
+func getMetadata(client *http.Client, token, path string) (string, error) {
+	req, err := http.NewRequest(http.MethodGet, metadataEndpoint+path, nil)
+	if err != nil {
+		return "", err
+	}
+	if token != "" {
+		req.Header.Set("X-aws-ec2-metadata-token", token)
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return "", nil
+	}
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("instance metadata returned %s for %s", res.Status, path)
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(body)), nil
+}
+
+// getTaskRegion read the region from the task ARN published by the ECS task metadata endpoint
+func getTaskRegion() (string, error) {
+	uri := os.Getenv("ECS_CONTAINER_METADATA_URI_V4")
+	if uri == "" {
+		uri = os.Getenv("ECS_CONTAINER_METADATA_URI")
+	}
+	if uri == "" {
+		return "", nil
+	}
+
+	client := &http.Client{Timeout: metadataTimeout}
+
+	res, err := client.Get(strings.TrimRight(uri, "/") + "/task")
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("task metadata returned %s", res.Status)
+	}
+
+	task := struct {
+		TaskARN string
+	}{}
+
+	if err := json.NewDecoder(res.Body).Decode(&task); err != nil {
+		return "", err
+	}
+
+	// arn:aws:ecs:region:account:task/...
+	parts := strings.Split(task.TaskARN, ":")
+	if len(parts) < 4 {
+		return "", fmt.Errorf("unable to parse task arn %q", task.TaskARN)
+	}
+
+	return parts[3], nil
+}
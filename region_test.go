@@ -0,0 +1,148 @@
+package unicreds
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// isolateRegionEnv clear any region configuration from the environment, returning a func
+// which restores it
+func isolateRegionEnv() func() {
+	vars := map[string]string{
+		"AWS_REGION":                    "",
+		"AWS_DEFAULT_REGION":            "",
+		"AWS_PROFILE":                   "",
+		"AWS_CONFIG_FILE":               "/nonexistent/config",
+		"AWS_SHARED_CREDENTIALS_FILE":   "/nonexistent/credentials",
+		"AWS_EC2_METADATA_DISABLED":     "",
+		"ECS_CONTAINER_METADATA_URI":    "",
+		"ECS_CONTAINER_METADATA_URI_V4": "",
+	}
+
+	saved := map[string]string{}
+	for k, v := range vars {
+		saved[k] = os.Getenv(k)
+		os.Setenv(k, v)
+	}
+
+	endpoint := metadataEndpoint
+
+	return func() {
+		for k, v := range saved {
+			os.Setenv(k, v)
+		}
+		metadataEndpoint = endpoint
+	}
+}
+
+func newMetadataStub(tokenRequired bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/latest/api/token":
+			if !tokenRequired {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			if r.Header.Get("X-aws-ec2-metadata-token-ttl-seconds") == "" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.Write([]byte("token123"))
+		case tokenRequired && r.Header.Get("X-aws-ec2-metadata-token") != "token123":
+			w.WriteHeader(http.StatusUnauthorized)
+		case r.URL.Path == "/latest/meta-data/placement/availability-zone":
+			w.Write([]byte("ap-southeast-2b"))
+		case r.URL.Path == "/latest/meta-data/placement/region" && tokenRequired:
+			w.Write([]byte("ap-southeast-2"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestResolveRegionPrecedence(t *testing.T) {
+	defer isolateRegionEnv()()
+
+	os.Setenv("AWS_DEFAULT_REGION", "eu-west-1")
+
+	region, source, err := ResolveRegion("us-west-2", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "us-west-2", region)
+	assert.Equal(t, RegionSourceFlag, source)
+
+	region, source, err = ResolveRegion("", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "eu-west-1", region)
+	assert.Equal(t, RegionSourceEnv, source)
+
+	os.Setenv("AWS_REGION", "us-east-2")
+
+	region, _, err = ResolveRegion("", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "us-east-2", region)
+}
+
+func TestResolveRegionIMDSv2(t *testing.T) {
+	defer isolateRegionEnv()()
+
+	stub := newMetadataStub(true)
+	defer stub.Close()
+
+	SetMetadataEndpoint(stub.URL)
+
+	region, source, err := ResolveRegion("", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "ap-southeast-2", region)
+	assert.Equal(t, RegionSourceIMDS, source)
+}
+
+func TestResolveRegionIMDSv1(t *testing.T) {
+	defer isolateRegionEnv()()
+
+	stub := newMetadataStub(false)
+	defer stub.Close()
+
+	SetMetadataEndpoint(stub.URL)
+
+	region, source, err := ResolveRegion("", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "ap-southeast-2", region)
+	assert.Equal(t, RegionSourceIMDS, source)
+}
+
+func TestResolveRegionECS(t *testing.T) {
+	defer isolateRegionEnv()()
+
+	os.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v4/task" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"Cluster":"default","TaskARN":"arn:aws:ecs:us-west-2:123456789012:task/default/abc"}`))
+	}))
+	defer stub.Close()
+
+	os.Setenv("ECS_CONTAINER_METADATA_URI_V4", stub.URL+"/v4")
+
+	region, source, err := ResolveRegion("", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "us-west-2", region)
+	assert.Equal(t, RegionSourceECS, source)
+}
+
+func TestResolveRegionNotFound(t *testing.T) {
+	defer isolateRegionEnv()()
+
+	os.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+
+	region, source, err := ResolveRegion("", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "", region)
+	assert.Equal(t, "", source)
+}
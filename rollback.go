@@ -0,0 +1,71 @@
+package unicreds
+
+import (
+	"fmt"
+
+	"github.com/apex/log"
+)
+
+// Rollback copy a previous version of the secret forward as a new highest version, if
+// version is 0 the version before the latest is used. Returns the source and new versions.
+func Rollback(tableName *string, alias, name string, version int, encContext *EncryptionContextValue) (string, string, error) {
+	var source *DecryptedCredential
+	var err error
+
+	if version == 0 {
+		source, err = getPreviousVersion(tableName, name, encContext)
+	} else {
+		source, err = GetSecret(tableName, name, PaddedInt(version), encContext)
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	// keep the encryption context the source was stored with
+	if (encContext == nil || len(*encContext) == 0) && len(source.Context) > 0 {
+		encContext = NewEncryptionContextValue()
+		encContext.SetMap(source.Context)
+	}
+
+	newVersion, err := ResolveVersion(tableName, name, 0)
+	if err != nil {
+		return "", "", err
+	}
+
+	log.WithFields(log.Fields{"name": name, "from": source.Version, "version": newVersion}).Debug("Rolling back")
+
+	err = PutSecretWithOptions(tableName, alias, name, source.Secret, newVersion, encContext, &PutOptions{
+		RecordContext:       len(source.ContextKeys) > 0,
+		RecordContextValues: len(source.Context) > 0,
+		Binary:              source.Binary,
+		RolledBackFrom:      source.Version,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return source.Version, newVersion, nil
+}
+
+// getPreviousVersion returns the version before the latest
+func getPreviousVersion(tableName *string, name string, encContext *EncryptionContextValue) (*DecryptedCredential, error) {
+	creds, err := queryVersions(tableName, name, false)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(creds) {
+	case 0:
+		return nil, ErrSecretNotFound
+	case 1:
+		return nil, fmt.Errorf("%s only has one version", name)
+	}
+
+	cred := creds[1]
+
+	if err = assembleChunks(tableName, cred); err != nil {
+		return nil, err
+	}
+
+	return decryptCredential(cred, encContext)
+}
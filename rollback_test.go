@@ -0,0 +1,78 @@
+package unicreds
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/versent/unicreds/mocks"
+)
+
+func configureRollbackMock() (*mocks.DynamoDBAPI, *mocks.KMSAPI) {
+	dsMock, kmsMock := configureMock()
+
+	// newest first, as returned by a descending query
+	qo := &dynamodb.QueryOutput{
+		Items: []map[string]*dynamodb.AttributeValue{historyItem(PaddedInt(2)), historyItem(PaddedInt(1))},
+	}
+
+	dko := &kms.GenerateDataKeyOutput{
+		Plaintext:      append(append([]byte{}, dsPlainText...), dsPlainText...),
+		CiphertextBlob: []byte("wrapped"),
+	}
+
+	dsMock.On("Query", mock.AnythingOfType("*dynamodb.QueryInput")).Return(qo, nil)
+	dsMock.On("GetItem", mock.AnythingOfType("*dynamodb.GetItemInput")).Return(&dynamodb.GetItemOutput{Item: historyItem(PaddedInt(1))}, nil)
+	kmsMock.On("Decrypt", mock.AnythingOfType("*kms.DecryptInput")).Return(&kms.DecryptOutput{Plaintext: dsPlainText}, nil)
+	kmsMock.On("GenerateDataKey", mock.AnythingOfType("*kms.GenerateDataKeyInput")).Return(dko, nil)
+
+	return dsMock, kmsMock
+}
+
+func TestRollback(t *testing.T) {
+
+	dsMock, _ := configureRollbackMock()
+
+	dsMock.On("PutItem", mock.MatchedBy(func(input *dynamodb.PutItemInput) bool {
+		return aws.StringValue(input.Item["version"].S) == PaddedInt(3) &&
+			aws.StringValue(input.Item["rolled_back_from"].S) == PaddedInt(1)
+	})).Return(&dynamodb.PutItemOutput{}, nil)
+
+	from, version, err := Rollback(&tableName, "", "test", 0, NewEncryptionContextValue())
+
+	assert.Nil(t, err)
+	assert.Equal(t, PaddedInt(1), from)
+	assert.Equal(t, PaddedInt(3), version)
+	dsMock.AssertNumberOfCalls(t, "PutItem", 1)
+	dsMock.AssertNumberOfCalls(t, "GetItem", 0)
+}
+
+func TestRollbackToVersion(t *testing.T) {
+
+	dsMock, _ := configureRollbackMock()
+
+	dsMock.On("PutItem", mock.AnythingOfType("*dynamodb.PutItemInput")).Return(&dynamodb.PutItemOutput{}, nil)
+
+	from, version, err := Rollback(&tableName, "", "test", 1, NewEncryptionContextValue())
+
+	assert.Nil(t, err)
+	assert.Equal(t, PaddedInt(1), from)
+	assert.Equal(t, PaddedInt(3), version)
+	dsMock.AssertNumberOfCalls(t, "GetItem", 1)
+}
+
+func TestRollbackSingleVersion(t *testing.T) {
+
+	dsMock, _ := configureMock()
+
+	dsMock.On("Query", mock.AnythingOfType("*dynamodb.QueryInput")).Return(&dynamodb.QueryOutput{
+		Items: []map[string]*dynamodb.AttributeValue{historyItem(PaddedInt(1))},
+	}, nil)
+
+	_, _, err := Rollback(&tableName, "", "test", 0, NewEncryptionContextValue())
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,273 @@
+package unicreds
+
+import (
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// TableOptions optional settings applied to the credential table during setup
+type TableOptions struct {
+	// BillingMode either PROVISIONED or PAY_PER_REQUEST, when empty new tables are
+	// provisioned and existing tables are left unchanged
+	BillingMode string
+
+	// Tags applied to the table, existing tags which aren't listed are kept
+	Tags map[string]string
+
+	// SSEKMSKeyID KMS key id, ARN or alias used to encrypt the table at rest
+	SSEKMSKeyID string
+
+	// PointInTimeRecovery enable continuous backups for the table
+	PointInTimeRecovery bool
+
+	// DeletionProtection prevent the table from being deleted
+	DeletionProtection bool
+
+	// Streams enable a KEYS_ONLY stream of changes to the table, an existing stream with
+	// another view type is kept as it also includes the keys
+	Streams bool
+}
+
+// SetupWithOptions create the table which stores credentials, if the table already exists
+// it is reconciled with the supplied options. Settings are only ever enabled, never disabled.
+func SetupWithOptions(tableName *string, read, write *int64, opts *TableOptions) error {
+	log.Debug("Running Setup")
+
+	if opts == nil {
+		opts = &TableOptions{}
+	}
+
+	res, err := dynamoSvc.DescribeTable(&dynamodb.DescribeTableInput{
+		TableName: tableName,
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != dynamodb.ErrCodeResourceNotFoundException {
+			return err
+		}
+
+		err = createTable(tableName, read, write, opts)
+	} else {
+		log.WithField("table", aws.StringValue(tableName)).Debug("Table exists, reconciling settings")
+
+		err = reconcileTable(tableName, res.Table, read, write, opts)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if opts.PointInTimeRecovery {
+		return enablePointInTimeRecovery(tableName)
+	}
+
+	return nil
+}
+
+func createTable(tableName *string, read, write *int64, opts *TableOptions) error {
+	log.WithField("table", aws.StringValue(tableName)).Debug("Creating table")
+
+	input := &dynamodb.CreateTableInput{
+		AttributeDefinitions: []*dynamodb.AttributeDefinition{
+			{
+				AttributeName: aws.String("name"),
+				AttributeType: aws.String("S"),
+			},
+			{
+				AttributeName: aws.String("version"),
+				AttributeType: aws.String("S"),
+			},
+		},
+		KeySchema: []*dynamodb.KeySchemaElement{
+			{
+				AttributeName: aws.String("name"),
+				KeyType:       aws.String(dynamodb.KeyTypeHash),
+			},
+			{
+				AttributeName: aws.String("version"),
+				KeyType:       aws.String(dynamodb.KeyTypeRange),
+			},
+		},
+		TableName: tableName,
+		Tags:      dynamoTags(opts.Tags),
+	}
+
+	if opts.BillingMode == dynamodb.BillingModePayPerRequest {
+		input.BillingMode = aws.String(dynamodb.BillingModePayPerRequest)
+	} else {
+		input.ProvisionedThroughput = &dynamodb.ProvisionedThroughput{
+			ReadCapacityUnits:  read,
+			WriteCapacityUnits: write,
+		}
+	}
+
+	if opts.SSEKMSKeyID != "" {
+		input.SSESpecification = kmsSSESpecification(opts.SSEKMSKeyID)
+	}
+
+	if opts.DeletionProtection {
+		input.DeletionProtectionEnabled = aws.Bool(true)
+	}
+
+	if opts.Streams {
+		input.StreamSpecification = keysOnlyStreamSpecification()
+	}
+
+	_, err := dynamoSvc.CreateTable(input)
+	if err != nil {
+		return err
+	}
+
+	return waitForTable(tableName)
+}
+
+// reconcileTable apply any settings which differ from the existing table, dynamodb only
+// permits one kind of change per update so each is applied separately
+func reconcileTable(tableName *string, table *dynamodb.TableDescription, read, write *int64, opts *TableOptions) error {
+
+	currentBillingMode := dynamodb.BillingModeProvisioned
+	if table.BillingModeSummary != nil && table.BillingModeSummary.BillingMode != nil {
+		currentBillingMode = aws.StringValue(table.BillingModeSummary.BillingMode)
+	}
+
+	if opts.BillingMode != "" && opts.BillingMode != currentBillingMode {
+		input := &dynamodb.UpdateTableInput{
+			TableName:   tableName,
+			BillingMode: aws.String(opts.BillingMode),
+		}
+
+		if opts.BillingMode == dynamodb.BillingModeProvisioned {
+			input.ProvisionedThroughput = &dynamodb.ProvisionedThroughput{
+				ReadCapacityUnits:  read,
+				WriteCapacityUnits: write,
+			}
+		}
+
+		log.WithFields(log.Fields{"from": currentBillingMode, "to": opts.BillingMode}).Info("Updating billing mode")
+
+		if err := updateTable(input); err != nil {
+			return err
+		}
+	}
+
+	if opts.SSEKMSKeyID != "" {
+		keyArn, err := describeKeyArn(opts.SSEKMSKeyID)
+		if err != nil {
+			return err
+		}
+
+		sse := table.SSEDescription
+		if sse == nil || aws.StringValue(sse.SSEType) != dynamodb.SSETypeKms || aws.StringValue(sse.KMSMasterKeyArn) != keyArn {
+			log.WithField("key", keyArn).Info("Updating server side encryption")
+
+			err = updateTable(&dynamodb.UpdateTableInput{
+				TableName:        tableName,
+				SSESpecification: kmsSSESpecification(keyArn),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if opts.DeletionProtection && !aws.BoolValue(table.DeletionProtectionEnabled) {
+		log.Info("Enabling deletion protection")
+
+		err := updateTable(&dynamodb.UpdateTableInput{
+			TableName:                 tableName,
+			DeletionProtectionEnabled: aws.Bool(true),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if opts.Streams && (table.StreamSpecification == nil || !aws.BoolValue(table.StreamSpecification.StreamEnabled)) {
+		log.Info("Enabling streams")
+
+		err := updateTable(&dynamodb.UpdateTableInput{
+			TableName:           tableName,
+			StreamSpecification: keysOnlyStreamSpecification(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(opts.Tags) > 0 {
+		_, err := dynamoSvc.TagResource(&dynamodb.TagResourceInput{
+			ResourceArn: table.TableArn,
+			Tags:        dynamoTags(opts.Tags),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func updateTable(input *dynamodb.UpdateTableInput) error {
+	_, err := dynamoSvc.UpdateTable(input)
+	if err != nil {
+		return err
+	}
+
+	return waitForTable(input.TableName)
+}
+
+func enablePointInTimeRecovery(tableName *string) error {
+	res, err := dynamoSvc.DescribeContinuousBackups(&dynamodb.DescribeContinuousBackupsInput{
+		TableName: tableName,
+	})
+	if err != nil {
+		return err
+	}
+
+	if desc := res.ContinuousBackupsDescription; desc != nil && desc.PointInTimeRecoveryDescription != nil {
+		if aws.StringValue(desc.PointInTimeRecoveryDescription.PointInTimeRecoveryStatus) == dynamodb.PointInTimeRecoveryStatusEnabled {
+			return nil
+		}
+	}
+
+	log.Info("Enabling point in time recovery")
+
+	_, err = dynamoSvc.UpdateContinuousBackups(&dynamodb.UpdateContinuousBackupsInput{
+		TableName: tableName,
+		PointInTimeRecoverySpecification: &dynamodb.PointInTimeRecoverySpecification{
+			PointInTimeRecoveryEnabled: aws.Bool(true),
+		},
+	})
+
+	return err
+}
+
+func kmsSSESpecification(keyID string) *dynamodb.SSESpecification {
+	return &dynamodb.SSESpecification{
+		Enabled:        aws.Bool(true),
+		SSEType:        aws.String(dynamodb.SSETypeKms),
+		KMSMasterKeyId: aws.String(keyID),
+	}
+}
+
+func keysOnlyStreamSpecification() *dynamodb.StreamSpecification {
+	return &dynamodb.StreamSpecification{
+		StreamEnabled:  aws.Bool(true),
+		StreamViewType: aws.String(dynamodb.StreamViewTypeKeysOnly),
+	}
+}
+
+func dynamoTags(tags map[string]string) []*dynamodb.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	results := make([]*dynamodb.Tag, 0, len(tags))
+
+	for k, v := range tags {
+		results = append(results, &dynamodb.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+
+	return results
+}
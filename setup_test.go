@@ -0,0 +1,141 @@
+package unicreds
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSetupWithOptionsCreatesTable(t *testing.T) {
+
+	dsMock, _ := configureMock()
+
+	notFound := awserr.New(dynamodb.ErrCodeResourceNotFoundException, "Requested resource not found", nil)
+
+	dto := &dynamodb.DescribeTableOutput{
+		Table: &dynamodb.TableDescription{TableStatus: aws.String("ACTIVE")},
+	}
+
+	createInput := mock.MatchedBy(func(in *dynamodb.CreateTableInput) bool {
+		return aws.StringValue(in.BillingMode) == dynamodb.BillingModePayPerRequest &&
+			in.ProvisionedThroughput == nil &&
+			aws.StringValue(in.SSESpecification.KMSMasterKeyId) == "alias/dynamo" &&
+			aws.BoolValue(in.DeletionProtectionEnabled) &&
+			len(in.Tags) == 1
+	})
+
+	cbo := &dynamodb.DescribeContinuousBackupsOutput{
+		ContinuousBackupsDescription: &dynamodb.ContinuousBackupsDescription{
+			PointInTimeRecoveryDescription: &dynamodb.PointInTimeRecoveryDescription{
+				PointInTimeRecoveryStatus: aws.String(dynamodb.PointInTimeRecoveryStatusDisabled),
+			},
+		},
+	}
+
+	dsMock.On("DescribeTable", mock.AnythingOfType("*dynamodb.DescribeTableInput")).Return(nil, notFound).Once()
+	dsMock.On("DescribeTable", mock.AnythingOfType("*dynamodb.DescribeTableInput")).Return(dto, nil)
+	dsMock.On("CreateTable", createInput).Return(nil, nil)
+	dsMock.On("DescribeContinuousBackups", mock.AnythingOfType("*dynamodb.DescribeContinuousBackupsInput")).Return(cbo, nil)
+	dsMock.On("UpdateContinuousBackups", mock.AnythingOfType("*dynamodb.UpdateContinuousBackupsInput")).Return(nil, nil)
+
+	err := SetupWithOptions(&tableName, &readCapacity, &writeCapacity, &TableOptions{
+		BillingMode:         dynamodb.BillingModePayPerRequest,
+		Tags:                map[string]string{"team": "platform"},
+		SSEKMSKeyID:         "alias/dynamo",
+		PointInTimeRecovery: true,
+		DeletionProtection:  true,
+	})
+
+	assert.Nil(t, err)
+	dsMock.AssertNumberOfCalls(t, "CreateTable", 1)
+	dsMock.AssertNumberOfCalls(t, "UpdateContinuousBackups", 1)
+}
+
+func TestSetupWithOptionsReconcilesTable(t *testing.T) {
+
+	dsMock, kmsMock := configureMock()
+
+	dto := &dynamodb.DescribeTableOutput{
+		Table: &dynamodb.TableDescription{
+			TableArn:    aws.String("arn:aws:dynamodb:us-west-2:123456789012:table/credential-store"),
+			TableStatus: aws.String("ACTIVE"),
+			SSEDescription: &dynamodb.SSEDescription{
+				SSEType:         aws.String(dynamodb.SSETypeKms),
+				KMSMasterKeyArn: aws.String("arn:aws:kms:us-west-2:123456789012:key/abc"),
+			},
+		},
+	}
+
+	dko := &kms.DescribeKeyOutput{
+		KeyMetadata: &kms.KeyMetadata{Arn: aws.String("arn:aws:kms:us-west-2:123456789012:key/abc")},
+	}
+
+	billingInput := mock.MatchedBy(func(in *dynamodb.UpdateTableInput) bool {
+		return aws.StringValue(in.BillingMode) == dynamodb.BillingModePayPerRequest
+	})
+
+	protectionInput := mock.MatchedBy(func(in *dynamodb.UpdateTableInput) bool {
+		return aws.BoolValue(in.DeletionProtectionEnabled)
+	})
+
+	dsMock.On("DescribeTable", mock.AnythingOfType("*dynamodb.DescribeTableInput")).Return(dto, nil)
+	dsMock.On("UpdateTable", billingInput).Return(nil, nil).Once()
+	dsMock.On("UpdateTable", protectionInput).Return(nil, nil).Once()
+	dsMock.On("TagResource", mock.AnythingOfType("*dynamodb.TagResourceInput")).Return(nil, nil)
+	kmsMock.On("DescribeKey", mock.AnythingOfType("*kms.DescribeKeyInput")).Return(dko, nil)
+
+	err := SetupWithOptions(&tableName, &readCapacity, &writeCapacity, &TableOptions{
+		BillingMode:        dynamodb.BillingModePayPerRequest,
+		Tags:               map[string]string{"team": "platform"},
+		SSEKMSKeyID:        "alias/dynamo",
+		DeletionProtection: true,
+	})
+
+	assert.Nil(t, err)
+	dsMock.AssertNotCalled(t, "CreateTable", mock.Anything)
+	dsMock.AssertNumberOfCalls(t, "UpdateTable", 2)
+	dsMock.AssertNumberOfCalls(t, "TagResource", 1)
+}
+
+func TestSetupWithOptionsEnablesStreams(t *testing.T) {
+
+	dsMock, _ := configureMock()
+
+	dto := &dynamodb.DescribeTableOutput{
+		Table: &dynamodb.TableDescription{
+			TableStatus: aws.String("ACTIVE"),
+		},
+	}
+
+	streamInput := mock.MatchedBy(func(in *dynamodb.UpdateTableInput) bool {
+		return in.StreamSpecification != nil && aws.StringValue(in.StreamSpecification.StreamViewType) == dynamodb.StreamViewTypeKeysOnly
+	})
+
+	dsMock.On("DescribeTable", mock.AnythingOfType("*dynamodb.DescribeTableInput")).Return(dto, nil)
+	dsMock.On("UpdateTable", streamInput).Return(nil, nil).Once()
+
+	err := SetupWithOptions(&tableName, &readCapacity, &writeCapacity, &TableOptions{Streams: true})
+
+	assert.Nil(t, err)
+	dsMock.AssertNumberOfCalls(t, "UpdateTable", 1)
+
+	// already enabled
+	dsMock, _ = configureMock()
+
+	dto.Table.StreamSpecification = &dynamodb.StreamSpecification{
+		StreamEnabled:  aws.Bool(true),
+		StreamViewType: aws.String(dynamodb.StreamViewTypeNewImage),
+	}
+
+	dsMock.On("DescribeTable", mock.AnythingOfType("*dynamodb.DescribeTableInput")).Return(dto, nil)
+
+	err = SetupWithOptions(&tableName, &readCapacity, &writeCapacity, &TableOptions{Streams: true})
+
+	assert.Nil(t, err)
+	dsMock.AssertNotCalled(t, "UpdateTable", mock.Anything)
+}
@@ -0,0 +1,225 @@
+package unicreds
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodbstreams"
+	"github.com/aws/aws-sdk-go/service/dynamodbstreams/dynamodbstreamsiface"
+)
+
+const (
+	streamPollInterval    = time.Second
+	streamRefreshInterval = time.Minute
+	streamRecordsLimit    = 1000
+)
+
+var (
+	streamsSvc dynamodbstreamsiface.DynamoDBStreamsAPI
+
+	// ErrStreamsDisabled returned when subscribing to a table which doesn't have a stream
+	ErrStreamsDisabled = errors.New("Streams are not enabled on the table, run setup --streams")
+)
+
+func init() {
+	streamsSvc = dynamodbstreams.New(session.New(), aws.NewConfig())
+}
+
+// SetDynamoDBStreamsSession override the default aws session
+func SetDynamoDBStreamsSession(sess *session.Session) {
+	streamsSvc = dynamodbstreams.New(sess)
+}
+
+// SecretEvent a change to a secret read from the table stream, the event is one of INSERT,
+// MODIFY or REMOVE. Only the keys are included so it never contains the secret.
+type SecretEvent struct {
+	Name    string    `json:"name"`
+	Version string    `json:"version"`
+	Event   string    `json:"event"`
+	Time    time.Time `json:"time"`
+}
+
+// SecretEventHandler called with each change read from the stream
+type SecretEventHandler func(*SecretEvent) error
+
+// shardReader position within a shard of the stream
+type shardReader struct {
+	id        string
+	iterator  *string
+	startType string
+	lastSeq   string
+}
+
+// Subscribe read changes from the table stream until the context is done, calling the handler
+// for each change to a secret. Only changes made after subscribing are read, shards created
+// while subscribed are read from the start so no changes are missed. An error from the
+// handler stops the subscription.
+func Subscribe(ctx context.Context, tableName *string, handler SecretEventHandler) error {
+	res, err := dynamoSvc.DescribeTable(&dynamodb.DescribeTableInput{TableName: tableName})
+	if err != nil {
+		return err
+	}
+
+	streamArn := res.Table.LatestStreamArn
+	if aws.StringValue(streamArn) == "" {
+		return ErrStreamsDisabled
+	}
+
+	log.WithField("stream", aws.StringValue(streamArn)).Debug("Subscribing")
+
+	shards := map[string]*shardReader{}
+	finished := map[string]bool{}
+
+	if err = refreshShards(streamArn, shards, finished, true); err != nil {
+		return err
+	}
+	refreshed := time.Now()
+
+	for {
+		closed := false
+
+		for id, shard := range shards {
+			if err = readShard(streamArn, shard, handler); err != nil {
+				return err
+			}
+
+			if shard.iterator == nil {
+				log.WithField("shard", id).Debug("Shard closed")
+				delete(shards, id)
+				finished[id] = true
+				closed = true
+			}
+		}
+
+		if closed || time.Since(refreshed) > streamRefreshInterval {
+			if err = refreshShards(streamArn, shards, finished, false); err != nil {
+				return err
+			}
+			refreshed = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(streamPollInterval):
+		}
+	}
+}
+
+// refreshShards start reading any shards which are new, when subscribing closed shards are
+// skipped and open shards are read from the latest record
+func refreshShards(streamArn *string, shards map[string]*shardReader, finished map[string]bool, initial bool) error {
+	var start *string
+
+	for {
+		res, err := streamsSvc.DescribeStream(&dynamodbstreams.DescribeStreamInput{
+			StreamArn:             streamArn,
+			ExclusiveStartShardId: start,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, shard := range res.StreamDescription.Shards {
+			id := aws.StringValue(shard.ShardId)
+			if shards[id] != nil || finished[id] {
+				continue
+			}
+
+			reader := &shardReader{id: id, startType: dynamodbstreams.ShardIteratorTypeTrimHorizon}
+
+			if initial {
+				if shard.SequenceNumberRange != nil && shard.SequenceNumberRange.EndingSequenceNumber != nil {
+					finished[id] = true
+					continue
+				}
+				reader.startType = dynamodbstreams.ShardIteratorTypeLatest
+			}
+
+			if err = reader.reset(streamArn); err != nil {
+				return err
+			}
+			shards[id] = reader
+		}
+
+		start = res.StreamDescription.LastEvaluatedShardId
+		if start == nil {
+			return nil
+		}
+	}
+}
+
+// reset get a new iterator following the last record read, or from the start position if
+// nothing has been read
+func (s *shardReader) reset(streamArn *string) error {
+	input := &dynamodbstreams.GetShardIteratorInput{
+		StreamArn:         streamArn,
+		ShardId:           aws.String(s.id),
+		ShardIteratorType: aws.String(s.startType),
+	}
+
+	if s.lastSeq != "" {
+		input.ShardIteratorType = aws.String(dynamodbstreams.ShardIteratorTypeAfterSequenceNumber)
+		input.SequenceNumber = aws.String(s.lastSeq)
+	}
+
+	res, err := streamsSvc.GetShardIterator(input)
+	if err != nil {
+		return err
+	}
+
+	s.iterator = res.ShardIterator
+
+	return nil
+}
+
+func readShard(streamArn *string, shard *shardReader, handler SecretEventHandler) error {
+	res, err := streamsSvc.GetRecords(&dynamodbstreams.GetRecordsInput{
+		ShardIterator: shard.iterator,
+		Limit:         aws.Int64(streamRecordsLimit),
+	})
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == dynamodbstreams.ErrCodeExpiredIteratorException {
+		log.WithField("shard", shard.id).Debug("Shard iterator expired")
+		return shard.reset(streamArn)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, record := range res.Records {
+		shard.lastSeq = aws.StringValue(record.Dynamodb.SequenceNumber)
+
+		name := streamKey(record.Dynamodb.Keys, "name")
+		if strings.Contains(name, chunkMarker) {
+			continue
+		}
+
+		err = handler(&SecretEvent{
+			Name:    name,
+			Version: streamKey(record.Dynamodb.Keys, "version"),
+			Event:   aws.StringValue(record.EventName),
+			Time:    aws.TimeValue(record.Dynamodb.ApproximateCreationDateTime),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	shard.iterator = res.NextShardIterator
+
+	return nil
+}
+
+func streamKey(keys map[string]*dynamodb.AttributeValue, name string) string {
+	if value, ok := keys[name]; ok && value != nil {
+		return aws.StringValue(value.S)
+	}
+	return ""
+}
@@ -0,0 +1,131 @@
+package unicreds
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodbstreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/versent/unicreds/mocks"
+)
+
+func configureStreamsMock() (*mocks.DynamoDBAPI, *mocks.DynamoDBStreamsAPI) {
+	dsMock, _ := configureMock()
+	streamsMock := &mocks.DynamoDBStreamsAPI{}
+
+	streamsSvc = streamsMock
+
+	dsMock.On("DescribeTable", mock.AnythingOfType("*dynamodb.DescribeTableInput")).Return(&dynamodb.DescribeTableOutput{
+		Table: &dynamodb.TableDescription{LatestStreamArn: aws.String("arn:stream")},
+	}, nil)
+
+	streamsMock.On("DescribeStream", mock.AnythingOfType("*dynamodbstreams.DescribeStreamInput")).Return(&dynamodbstreams.DescribeStreamOutput{
+		StreamDescription: &dynamodbstreams.StreamDescription{
+			Shards: []*dynamodbstreams.Shard{
+				{
+					ShardId:             aws.String("closed"),
+					SequenceNumberRange: &dynamodbstreams.SequenceNumberRange{EndingSequenceNumber: aws.String("5")},
+				},
+				{
+					ShardId:             aws.String("open"),
+					SequenceNumberRange: &dynamodbstreams.SequenceNumberRange{StartingSequenceNumber: aws.String("6")},
+				},
+			},
+		},
+	}, nil)
+
+	return dsMock, streamsMock
+}
+
+func streamRecord(event, name, version, seq string) *dynamodbstreams.Record {
+	return &dynamodbstreams.Record{
+		EventName: aws.String(event),
+		Dynamodb: &dynamodbstreams.StreamRecord{
+			Keys: map[string]*dynamodb.AttributeValue{
+				"name":    {S: aws.String(name)},
+				"version": {S: aws.String(version)},
+			},
+			SequenceNumber: aws.String(seq),
+		},
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+
+	_, streamsMock := configureStreamsMock()
+
+	latestInput := mock.MatchedBy(func(in *dynamodbstreams.GetShardIteratorInput) bool {
+		return aws.StringValue(in.ShardId) == "open" && aws.StringValue(in.ShardIteratorType) == dynamodbstreams.ShardIteratorTypeLatest
+	})
+
+	streamsMock.On("GetShardIterator", latestInput).Return(&dynamodbstreams.GetShardIteratorOutput{ShardIterator: aws.String("it1")}, nil)
+	streamsMock.On("GetRecords", mock.AnythingOfType("*dynamodbstreams.GetRecordsInput")).Return(&dynamodbstreams.GetRecordsOutput{
+		Records: []*dynamodbstreams.Record{
+			streamRecord(dynamodbstreams.OperationTypeInsert, "test#chunk#abc", PaddedInt(1), "7"),
+			streamRecord(dynamodbstreams.OperationTypeInsert, "test", PaddedInt(1), "8"),
+			streamRecord(dynamodbstreams.OperationTypeRemove, "other", PaddedInt(3), "9"),
+		},
+		NextShardIterator: aws.String("it2"),
+	}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var events []*SecretEvent
+	err := Subscribe(ctx, &tableName, func(event *SecretEvent) error {
+		events = append(events, event)
+		cancel()
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, "test", events[0].Name)
+	assert.Equal(t, PaddedInt(1), events[0].Version)
+	assert.Equal(t, dynamodbstreams.OperationTypeInsert, events[0].Event)
+	assert.Equal(t, "other", events[1].Name)
+	assert.Equal(t, dynamodbstreams.OperationTypeRemove, events[1].Event)
+	streamsMock.AssertNumberOfCalls(t, "GetShardIterator", 1)
+}
+
+func TestSubscribeExpiredIterator(t *testing.T) {
+
+	_, streamsMock := configureStreamsMock()
+
+	streamsMock.On("GetShardIterator", mock.AnythingOfType("*dynamodbstreams.GetShardIteratorInput")).Return(&dynamodbstreams.GetShardIteratorOutput{ShardIterator: aws.String("it1")}, nil)
+	streamsMock.On("GetRecords", mock.AnythingOfType("*dynamodbstreams.GetRecordsInput")).Return(nil, awserr.New(dynamodbstreams.ErrCodeExpiredIteratorException, "expired", nil)).Once()
+	streamsMock.On("GetRecords", mock.AnythingOfType("*dynamodbstreams.GetRecordsInput")).Return(&dynamodbstreams.GetRecordsOutput{
+		Records: []*dynamodbstreams.Record{streamRecord(dynamodbstreams.OperationTypeModify, "test", PaddedInt(1), "8")},
+	}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var events []*SecretEvent
+	err := Subscribe(ctx, &tableName, func(event *SecretEvent) error {
+		events = append(events, event)
+		cancel()
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.Len(t, events, 1)
+	streamsMock.AssertNumberOfCalls(t, "GetShardIterator", 2)
+}
+
+func TestSubscribeStreamsDisabled(t *testing.T) {
+
+	dsMock, _ := configureMock()
+
+	dsMock.On("DescribeTable", mock.AnythingOfType("*dynamodb.DescribeTableInput")).Return(&dynamodb.DescribeTableOutput{
+		Table: &dynamodb.TableDescription{},
+	}, nil)
+
+	err := Subscribe(context.Background(), &tableName, func(*SecretEvent) error { return nil })
+
+	assert.Equal(t, ErrStreamsDisabled, err)
+}
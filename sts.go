@@ -0,0 +1,29 @@
+package unicreds
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+)
+
+var stsSvc stsiface.STSAPI
+
+func init() {
+	stsSvc = sts.New(session.New(), aws.NewConfig())
+}
+
+// SetSTSSession override the default aws session
+func SetSTSSession(sess *session.Session) {
+	stsSvc = sts.New(sess)
+}
+
+// GetCallerIdentity return the ARN of the identity making requests
+func GetCallerIdentity() (string, error) {
+	resp, err := stsSvc.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(resp.Arn), nil
+}
@@ -0,0 +1,300 @@
+package unicreds
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/apex/log"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const (
+	// SyncCreate the secret doesn't exist and will be stored
+	SyncCreate = "create"
+	// SyncUpdate the secret has a different value and a new version will be stored
+	SyncUpdate = "update"
+	// SyncUnchanged the secret already has the desired value
+	SyncUnchanged = "unchanged"
+	// SyncDelete the secret isn't in the manifest and will be deleted
+	SyncDelete = "delete"
+
+	defaultGeneratedLength = 32
+)
+
+// ErrDeleteExtraNoPrefix returned when deleting extra secrets without a manifest prefix, which
+// would delete every secret in the table that isn't in the manifest
+var ErrDeleteExtraNoPrefix = errors.New("--delete-extra requires a prefix in the manifest")
+
+var generatorCharsets = map[string]string{
+	"alphanumeric": "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789",
+	"hex":          "0123456789abcdef",
+	"ascii":        "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789!#$%&()*+,-./:;<=>?@[]^_{|}~",
+}
+
+// Manifest the desired secrets in a store
+type Manifest struct {
+	// Prefix limits the secrets deleted when removing secrets which aren't in the manifest
+	Prefix  string            `yaml:"prefix"`
+	Secrets []*ManifestSecret `yaml:"secrets"`
+}
+
+// ManifestSecret a secret and the source of its value, exactly one source must be set
+type ManifestSecret struct {
+	Name     string     `yaml:"name"`
+	Literal  *string    `yaml:"literal"`
+	File     string     `yaml:"file"`
+	Env      string     `yaml:"env"`
+	Generate *Generator `yaml:"generate"`
+	Binary   bool       `yaml:"binary"`
+}
+
+// Generator settings for a randomly generated secret, generated secrets are only created
+// and never updated
+type Generator struct {
+	Length  int    `yaml:"length"`
+	Charset string `yaml:"charset"`
+}
+
+// SyncChange the action needed to bring a secret in line with the manifest
+type SyncChange struct {
+	Name   string
+	Action string
+
+	secret *ManifestSecret
+	value  string
+}
+
+// Secret the value which will be stored by a create or update
+func (c *SyncChange) Secret() string {
+	return c.value
+}
+
+// Binary the value will be stored as binary content
+func (c *SyncChange) Binary() bool {
+	return c.secret != nil && c.secret.Binary
+}
+
+// LoadManifest read and validate a manifest, file sources are relative to the manifest
+func LoadManifest(path string) (*Manifest, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := new(Manifest)
+	if err := yaml.UnmarshalStrict(data, manifest); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	seen := map[string]bool{}
+
+	for i, secret := range manifest.Secrets {
+		if secret == nil || secret.Name == "" {
+			return nil, fmt.Errorf("%s: secret %d has no name", path, i+1)
+		}
+		if seen[secret.Name] {
+			return nil, fmt.Errorf("%s: secret %s is listed more than once", path, secret.Name)
+		}
+		seen[secret.Name] = true
+
+		if err := secret.validate(); err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+
+		if secret.File != "" && !filepath.IsAbs(secret.File) {
+			secret.File = filepath.Join(filepath.Dir(path), secret.File)
+		}
+	}
+
+	return manifest, nil
+}
+
+func (s *ManifestSecret) validate() error {
+	sources := 0
+	if s.Literal != nil {
+		sources++
+	}
+	if s.File != "" {
+		sources++
+	}
+	if s.Env != "" {
+		sources++
+	}
+	if s.Generate != nil {
+		sources++
+		if _, ok := generatorCharsets[s.Generate.charset()]; !ok {
+			return fmt.Errorf("secret %s has an unknown charset %q", s.Name, s.Generate.Charset)
+		}
+	}
+
+	if sources != 1 {
+		return fmt.Errorf("secret %s must have exactly one of literal, file, env or generate", s.Name)
+	}
+
+	return nil
+}
+
+// Value read the value of the secret from its source
+func (s *ManifestSecret) Value() (string, error) {
+	switch {
+	case s.Literal != nil:
+		return *s.Literal, nil
+	case s.File != "":
+		data, err := ioutil.ReadFile(s.File)
+		return string(data), err
+	case s.Env != "":
+		value, ok := os.LookupEnv(s.Env)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s for secret %s is not set", s.Env, s.Name)
+		}
+		return value, nil
+	default:
+		return s.Generate.Generate()
+	}
+}
+
+func (g *Generator) charset() string {
+	if g.Charset == "" {
+		return "alphanumeric"
+	}
+	return g.Charset
+}
+
+// Generate returns a random value
+func (g *Generator) Generate() (string, error) {
+	length := g.Length
+	if length <= 0 {
+		length = defaultGeneratedLength
+	}
+
+	charset := generatorCharsets[g.charset()]
+	max := big.NewInt(int64(len(charset)))
+
+	var value strings.Builder
+	for i := 0; i < length; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		value.WriteByte(charset[n.Int64()])
+	}
+
+	return value.String(), nil
+}
+
+// PlanSync compare the manifest with the latest version of each secret in the store, secrets
+// with the manifest prefix which aren't in the manifest are deleted if deleteExtra is set
+func PlanSync(tableName *string, manifest *Manifest, deleteExtra bool, encContext *EncryptionContextValue) ([]*SyncChange, error) {
+	if deleteExtra && manifest.Prefix == "" {
+		return nil, ErrDeleteExtraNoPrefix
+	}
+
+	log.WithField("secrets", len(manifest.Secrets)).Debug("Planning sync")
+
+	existing, err := ListSecrets(tableName, false)
+	if err != nil {
+		return nil, err
+	}
+
+	decrypted, err := GetAllSecrets(tableName, false, encContext)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]string{}
+	for _, cred := range decrypted {
+		values[cred.Name] = cred.Secret
+	}
+
+	wanted := map[string]bool{}
+
+	var changes []*SyncChange
+
+	for _, secret := range manifest.Secrets {
+		wanted[secret.Name] = true
+
+		current, exists := values[secret.Name]
+
+		if !exists && containsCredential(existing, secret.Name) {
+			return nil, fmt.Errorf("unable to decrypt the existing value of %s", secret.Name)
+		}
+
+		change := &SyncChange{Name: secret.Name, secret: secret}
+
+		switch {
+		case exists && secret.Generate != nil:
+			change.Action = SyncUnchanged
+		case !exists:
+			change.Action = SyncCreate
+		default:
+			change.Action = SyncUpdate
+		}
+
+		if change.Action != SyncUnchanged {
+			if change.value, err = secret.Value(); err != nil {
+				return nil, err
+			}
+			if exists && change.value == current {
+				change.Action = SyncUnchanged
+			}
+		}
+
+		changes = append(changes, change)
+	}
+
+	if deleteExtra {
+		for _, cred := range existing {
+			if !wanted[cred.Name] && strings.HasPrefix(cred.Name, manifest.Prefix) {
+				changes = append(changes, &SyncChange{Name: cred.Name, Action: SyncDelete})
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+// ApplySync store or delete secrets to carry out the planned changes
+func ApplySync(tableName *string, alias string, changes []*SyncChange, encContext *EncryptionContextValue, opts *PutOptions) error {
+	for _, change := range changes {
+		switch change.Action {
+		case SyncCreate, SyncUpdate:
+			version, err := ResolveVersion(tableName, change.Name, 0)
+			if err != nil {
+				return err
+			}
+
+			putOpts := PutOptions{}
+			if opts != nil {
+				putOpts = *opts
+			}
+			putOpts.Binary = change.secret.Binary
+
+			if err = PutSecretWithOptions(tableName, alias, change.Name, change.value, version, encContext, &putOpts); err != nil {
+				return err
+			}
+
+			log.WithFields(log.Fields{"name": change.Name, "version": version}).Debug("Synced secret")
+		case SyncDelete:
+			if err := DeleteSecret(tableName, change.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func containsCredential(creds []*Credential, name string) bool {
+	for _, cred := range creds {
+		if cred.Name == name {
+			return true
+		}
+	}
+	return false
+}
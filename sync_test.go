@@ -0,0 +1,128 @@
+package unicreds
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func writeManifest(t *testing.T, dir, contents string) string {
+	path := filepath.Join(dir, "manifest.yaml")
+	assert.Nil(t, ioutil.WriteFile(path, []byte(contents), 0600))
+	return path
+}
+
+func TestLoadManifest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "unicreds")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "cert.pem"), []byte("cert"), 0600))
+	os.Setenv("UNICREDS_TEST_SYNC", "from env")
+	defer os.Unsetenv("UNICREDS_TEST_SYNC")
+
+	manifest, err := LoadManifest(writeManifest(t, dir, `
+prefix: app/
+secrets:
+  - name: app/literal
+    literal: value
+  - name: app/file
+    file: cert.pem
+  - name: app/env
+    env: UNICREDS_TEST_SYNC
+  - name: app/generated
+    generate:
+      length: 16
+      charset: hex
+`))
+	assert.Nil(t, err)
+	assert.Equal(t, "app/", manifest.Prefix)
+	assert.Len(t, manifest.Secrets, 4)
+
+	values := []string{"value", "cert", "from env"}
+	for i, value := range values {
+		v, err := manifest.Secrets[i].Value()
+		assert.Nil(t, err)
+		assert.Equal(t, value, v)
+	}
+
+	generated, err := manifest.Secrets[3].Value()
+	assert.Nil(t, err)
+	assert.Regexp(t, "^[0-9a-f]{16}$", generated)
+}
+
+func TestLoadManifestInvalid(t *testing.T) {
+	dir, err := ioutil.TempDir("", "unicreds")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = LoadManifest(writeManifest(t, dir, "secrets:\n  - name: app/none\n"))
+	assert.Error(t, err)
+
+	_, err = LoadManifest(writeManifest(t, dir, "secrets:\n  - name: app/two\n    literal: a\n    env: B\n"))
+	assert.Error(t, err)
+
+	_, err = LoadManifest(writeManifest(t, dir, "secrets:\n  - name: app/dup\n    literal: a\n  - name: app/dup\n    literal: b\n"))
+	assert.Error(t, err)
+
+	_, err = LoadManifest(writeManifest(t, dir, "secrets:\n  - name: app/gen\n    generate:\n      charset: emoji\n"))
+	assert.Error(t, err)
+}
+
+func TestPlanSync(t *testing.T) {
+
+	dsMock, kmsMock := configureMock()
+
+	dsMock.On("Scan", mock.AnythingOfType("*dynamodb.ScanInput")).Return(&dynamodb.ScanOutput{Items: itemsFixture}, nil)
+	kmsMock.On("Decrypt", mock.AnythingOfType("*kms.DecryptInput")).Return(&kms.DecryptOutput{Plaintext: dsPlainText}, nil)
+
+	plan := func(manifest *Manifest, deleteExtra bool) map[string]string {
+		changes, err := PlanSync(&tableName, manifest, deleteExtra, NewEncryptionContextValue())
+		assert.Nil(t, err)
+
+		actions := map[string]string{}
+		for _, change := range changes {
+			actions[change.Name] = change.Action
+		}
+		return actions
+	}
+
+	actions := plan(&Manifest{Secrets: []*ManifestSecret{
+		{Name: "test", Literal: aws.String("something test 123")},
+		{Name: "new", Literal: aws.String("value")},
+	}}, false)
+	assert.Equal(t, map[string]string{"test": SyncUnchanged, "new": SyncCreate}, actions)
+
+	actions = plan(&Manifest{Secrets: []*ManifestSecret{
+		{Name: "test", Literal: aws.String("something else")},
+	}}, false)
+	assert.Equal(t, map[string]string{"test": SyncUpdate}, actions)
+
+	actions = plan(&Manifest{Secrets: []*ManifestSecret{
+		{Name: "test", Generate: &Generator{}},
+	}}, false)
+	assert.Equal(t, map[string]string{"test": SyncUnchanged}, actions)
+
+	actions = plan(&Manifest{Prefix: "te", Secrets: []*ManifestSecret{}}, true)
+	assert.Equal(t, map[string]string{"test": SyncDelete}, actions)
+
+	actions = plan(&Manifest{Prefix: "app/", Secrets: []*ManifestSecret{}}, true)
+	assert.Empty(t, actions)
+}
+
+func TestPlanSyncDeleteExtraNoPrefix(t *testing.T) {
+
+	dsMock, _ := configureMock()
+
+	_, err := PlanSync(&tableName, &Manifest{Secrets: []*ManifestSecret{}}, true, NewEncryptionContextValue())
+
+	assert.Equal(t, ErrDeleteExtraNoPrefix, err)
+	dsMock.AssertNotCalled(t, "Scan", mock.Anything)
+}
@@ -0,0 +1,192 @@
+package unicreds
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/apex/log"
+)
+
+var invalidEnvChars = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// WatchHandler called with the names of the secrets which were added, changed or removed
+type WatchHandler func(changed []string) error
+
+// LatestVersions returns the highest version of each secret whose name starts with the prefix
+func LatestVersions(tableName *string, prefix string) (map[string]string, error) {
+	creds, err := ListSecrets(tableName, false)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := map[string]string{}
+	for _, cred := range creds {
+		if strings.HasPrefix(cred.Name, prefix) {
+			versions[cred.Name] = cred.Version
+		}
+	}
+
+	return versions, nil
+}
+
+// ChangedVersions returns the sorted names of the secrets which were added, removed or have
+// a different version
+func ChangedVersions(from, to map[string]string) []string {
+	var changed []string
+
+	for name, version := range to {
+		if from[name] != version {
+			changed = append(changed, name)
+		}
+	}
+	for name := range from {
+		if _, ok := to[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+
+	sort.Strings(changed)
+
+	return changed
+}
+
+// Watch poll the highest versions of the secrets with the prefix every interval until the
+// context is done, calling the handler with every name on the first poll and then with the
+// names of any secrets which changed. Errors from the store are logged and retried on the
+// next poll, an error from the handler stops the watch.
+func Watch(ctx context.Context, tableName *string, prefix string, interval time.Duration, handler WatchHandler) error {
+	return WatchTriggered(ctx, tableName, prefix, interval, nil, handler)
+}
+
+// WatchTriggered watch the secrets with the prefix, also polling as soon as the trigger
+// receives, such as when a change is read from the table stream
+func WatchTriggered(ctx context.Context, tableName *string, prefix string, interval time.Duration, trigger <-chan struct{}, handler WatchHandler) error {
+	versions, err := LatestVersions(tableName, prefix)
+	if err != nil {
+		return err
+	}
+
+	if err = handler(ChangedVersions(nil, versions)); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		case <-trigger:
+		}
+
+		latest, err := LatestVersions(tableName, prefix)
+		if err != nil {
+			log.WithError(err).Warn("Failed to poll versions")
+			continue
+		}
+
+		changed := ChangedVersions(versions, latest)
+		versions = latest
+
+		if len(changed) == 0 {
+			continue
+		}
+
+		log.WithField("changed", strings.Join(changed, ",")).Debug("Secrets changed")
+
+		if err = handler(changed); err != nil {
+			return err
+		}
+	}
+}
+
+// EnvName the environment variable name for a secret, the prefix is removed and any
+// characters which aren't allowed in variable names are replaced with underscores
+func EnvName(prefix, name string) string {
+	return invalidEnvChars.ReplaceAllString(strings.TrimPrefix(name, prefix), "_")
+}
+
+// WriteEnvFile write the secrets as NAME='value' lines which can be sourced by a shell or
+// loaded by tools which read .env files, the file is replaced atomically and only readable
+// by the owner
+func WriteEnvFile(path, prefix string, secrets map[string]string) error {
+	names := make([]string, 0, len(secrets))
+	for name := range secrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	for _, name := range names {
+		fmt.Fprintf(&buf, "%s=%s\n", EnvName(prefix, name), shellQuote(secrets[name]))
+	}
+
+	return writeFileAtomic(path, buf.Bytes())
+}
+
+// shellQuote single quote the value so a shell sourcing it doesn't expand $ or backticks
+func shellQuote(value string) string {
+	return "'" + strings.Replace(value, "'", `'\''`, -1) + "'"
+}
+
+// RenderTemplate render the text/template at src to dest, the secrets are passed to the
+// template as a map of name to value and are also available through the secret function,
+// such as {{ secret "app/db" }}. The file is replaced atomically and only readable by the
+// owner.
+func RenderTemplate(src, dest string, secrets map[string]string) error {
+	text, err := ioutil.ReadFile(src)
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := template.New(src).Option("missingkey=error").Funcs(template.FuncMap{
+		"secret": func(name string) (string, error) {
+			value, ok := secrets[name]
+			if !ok {
+				return "", fmt.Errorf("secret %s not found", name)
+			}
+			return value, nil
+		},
+	}).Parse(string(text))
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, secrets); err != nil {
+		return err
+	}
+
+	return writeFileAtomic(dest, buf.Bytes())
+}
+
+// writeFileAtomic replace the file in one step so readers never see a partial write, the
+// temporary file is created readable only by the owner
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,135 @@
+package unicreds
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func versionItem(name, version string) map[string]*dynamodb.AttributeValue {
+	return map[string]*dynamodb.AttributeValue{
+		"name":    {S: aws.String(name)},
+		"version": {S: aws.String(version)},
+	}
+}
+
+func TestChangedVersions(t *testing.T) {
+	from := map[string]string{"app/a": "1", "app/b": "1", "app/c": "1"}
+	to := map[string]string{"app/a": "1", "app/b": "2", "app/d": "1"}
+
+	assert.Equal(t, []string{"app/b", "app/c", "app/d"}, ChangedVersions(from, to))
+	assert.Nil(t, ChangedVersions(from, from))
+	assert.Equal(t, []string{"app/a", "app/b", "app/c"}, ChangedVersions(nil, from))
+}
+
+func TestWatch(t *testing.T) {
+
+	dsMock, _ := configureMock()
+
+	dsMock.On("Scan", mock.AnythingOfType("*dynamodb.ScanInput")).Return(&dynamodb.ScanOutput{
+		Items: []map[string]*dynamodb.AttributeValue{versionItem("app/a", PaddedInt(1)), versionItem("other", PaddedInt(1))},
+	}, nil).Once()
+	dsMock.On("Scan", mock.AnythingOfType("*dynamodb.ScanInput")).Return(&dynamodb.ScanOutput{
+		Items: []map[string]*dynamodb.AttributeValue{versionItem("app/a", PaddedInt(2)), versionItem("other", PaddedInt(2))},
+	}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls [][]string
+	err := Watch(ctx, &tableName, "app/", time.Millisecond, func(changed []string) error {
+		calls = append(calls, changed)
+		if len(calls) == 2 {
+			cancel()
+		}
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"app/a"}, {"app/a"}}, calls)
+}
+
+func TestWatchTriggered(t *testing.T) {
+
+	dsMock, _ := configureMock()
+
+	dsMock.On("Scan", mock.AnythingOfType("*dynamodb.ScanInput")).Return(&dynamodb.ScanOutput{
+		Items: []map[string]*dynamodb.AttributeValue{versionItem("app/a", PaddedInt(1))},
+	}, nil).Once()
+	dsMock.On("Scan", mock.AnythingOfType("*dynamodb.ScanInput")).Return(&dynamodb.ScanOutput{
+		Items: []map[string]*dynamodb.AttributeValue{versionItem("app/a", PaddedInt(1)), versionItem("app/b", PaddedInt(1))},
+	}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	trigger := make(chan struct{}, 1)
+	trigger <- struct{}{}
+
+	var calls [][]string
+	err := WatchTriggered(ctx, &tableName, "app/", time.Hour, trigger, func(changed []string) error {
+		calls = append(calls, changed)
+		if len(calls) == 2 {
+			cancel()
+		}
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"app/a"}, {"app/b"}}, calls)
+}
+
+func TestWriteEnvFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "unicreds")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "app.env")
+	err = WriteEnvFile(path, "app/", map[string]string{
+		"app/db-password": `p"w`,
+		"app/token":       `$(touch pwned) 'x' ` + "`id`",
+		"app/user":        "bob",
+	})
+	assert.Nil(t, err)
+
+	data, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "db_password='p\"w'\ntoken='$(touch pwned) '\\''x'\\'' `id`'\nuser='bob'\n", string(data))
+
+	info, err := os.Stat(path)
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestRenderTemplate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "unicreds")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	src := filepath.Join(dir, "config.tmpl")
+	dest := filepath.Join(dir, "config")
+	secrets := map[string]string{"app/db": "secret"}
+
+	err = ioutil.WriteFile(src, []byte(`password={{ secret "app/db" }} {{ index . "app/db" }}`), 0600)
+	assert.Nil(t, err)
+
+	err = RenderTemplate(src, dest, secrets)
+	assert.Nil(t, err)
+
+	data, err := ioutil.ReadFile(dest)
+	assert.Nil(t, err)
+	assert.Equal(t, "password=secret secret", string(data))
+
+	err = ioutil.WriteFile(src, []byte(`{{ secret "app/missing" }}`), 0600)
+	assert.Nil(t, err)
+
+	assert.Error(t, RenderTemplate(src, dest, secrets))
+}